@@ -1,40 +1,176 @@
 package compiler
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
 	"strings"
 
+	"github.com/candango/sqlok/internal/dialect"
 	"github.com/candango/sqlok/internal/sst"
 )
 
-// Compile compiles a statement node into SQL text and bound arguments.
-// The compiler currently implements SELECT rendering; additional statement
-// roots can use the same StatementNode boundary as their visitors are added.
+var genericDialect = dialect.Generic()
+
+// Compile compiles a statement node into SQL text and bound arguments using
+// the portable generic dialect. The compiler renders SELECT, set operation,
+// INSERT, UPDATE, and DELETE statement roots.
 func Compile(stmt sst.StatementNode) (string, []any, error) {
+	return CompileWith(stmt, genericDialect)
+}
+
+// CompileWith compiles a statement node into SQL text and bound arguments
+// using the placeholder, quoting, and capability rules of the provided
+// dialect.
+func CompileWith(stmt sst.StatementNode, d dialect.Dialect) (string, []any, error) {
 	if err := stmt.Err(); err != nil {
 		return "", nil, err
 	}
 
-	c := &Compiler{}
+	c := &Compiler{dialect: d}
 	if err := stmt.Accept(c); err != nil {
 		return "", nil, err
 	}
 	return strings.Join(c.parts, ""), c.args, nil
 }
 
-// Compiler walks SQL semantic tree nodes and renders SQL text.
+// Compiler walks SQL semantic tree nodes and renders SQL text for its dialect.
 type Compiler struct {
-	parts []string
-	args  []any
+	dialect  dialect.Dialect
+	parts    []string
+	args     []any
+	named    map[string]any
+	excluded bool
 }
 
 var _ sst.Visitor = (*Compiler)(nil)
 
-// VisitStatement renders a statement declaration.
+// VisitStatement renders a statement declaration. Nested statements, such as
+// subqueries, report their own construction errors here.
 func (c *Compiler) VisitStatement(stmt sst.StatementNode) error {
+	if err := stmt.Err(); err != nil {
+		return err
+	}
 	c.parts = append(c.parts, stmt.Declaration(), " ")
 	return nil
 }
 
+// VisitSetOperation renders a compound query. Operands are parenthesized
+// only where their meaning requires it; dialects without parenthesized
+// operands evaluate set operators left to right, so a left-deep chain renders
+// flat, and operands that would need parentheses are rejected.
+func (c *Compiler) VisitSetOperation(stmt sst.SetOperationNode) error {
+	if err := stmt.Err(); err != nil {
+		return err
+	}
+	if with := stmt.WithClause(); with != nil {
+		if err := with.Accept(c); err != nil {
+			return err
+		}
+	}
+	limit := stmt.LimitClause()
+	if limit != nil && !limit.Ordered() && !c.dialect.Supports(dialect.LimitOffset) {
+		return fmt.Errorf(
+			"LIMIT on a set operation requires ORDER BY for the %s dialect",
+			c.dialect.Name(),
+		)
+	}
+
+	if err := c.visitSetOperand(stmt, stmt.Left(), false); err != nil {
+		return err
+	}
+	c.parts = append(c.parts, " ", stmt.Declaration(), " ")
+	if err := c.visitSetOperand(stmt, stmt.Right(), true); err != nil {
+		return err
+	}
+
+	if ordering := stmt.Ordering(); ordering != nil {
+		if c.emulatesNullsOrdering(ordering) {
+			return fmt.Errorf(
+				"NULLS FIRST/LAST on a set operation is not supported by the %s dialect",
+				c.dialect.Name(),
+			)
+		}
+		c.parts = append(c.parts, " ORDER BY ")
+		if err := ordering.Accept(c); err != nil {
+			return err
+		}
+	}
+	if limit != nil {
+		return c.VisitLimit(limit, sst.LimitAfterOrdering)
+	}
+	return nil
+}
+
+func (c *Compiler) visitSetOperand(stmt sst.SetOperationNode, operand sst.StatementNode, right bool) error {
+	// Dialects that allow parenthesized operands rank INTERSECT above UNION
+	// and EXCEPT; the others evaluate set operators left to right.
+	ranked := c.dialect.Supports(dialect.ParenthesizedSetOperands)
+	if !sst.SetOperandRequiresGroup(stmt, operand, right, ranked) {
+		return operand.Accept(c)
+	}
+	if !ranked {
+		return fmt.Errorf(
+			"%s operand cannot be parenthesized for the %s dialect",
+			stmt.Declaration(), c.dialect.Name(),
+		)
+	}
+	if err := c.VisitExpressionGroupStart(); err != nil {
+		return err
+	}
+	if err := operand.Accept(c); err != nil {
+		return err
+	}
+	return c.VisitExpressionGroupEnd()
+}
+
+// VisitWith renders a WITH clause ahead of its statement root. RECURSIVE is
+// omitted for dialects that detect recursion themselves, and materialization
+// hints are rejected where the dialect does not support them.
+func (c *Compiler) VisitWith(w sst.WithClauseNode) error {
+	c.parts = append(c.parts, w.Declaration(), " ")
+	if w.Recursive() && c.dialect.Supports(dialect.RecursiveKeyword) {
+		c.parts = append(c.parts, "RECURSIVE ")
+	}
+
+	for i, cte := range w.Tables() {
+		if err := c.VisitListSeparator(i); err != nil {
+			return err
+		}
+		c.parts = append(c.parts, c.dialect.QuoteIdentifier(cte.Name()))
+		if columns := cte.Columns(); len(columns) > 0 {
+			c.parts = append(c.parts, " (", c.identifierList(columns), ")")
+		}
+		c.parts = append(c.parts, " AS ")
+
+		if hint := cte.Materialization(); hint != sst.MaterializeDefault {
+			if !c.dialect.Supports(dialect.MaterializedCTE) {
+				return fmt.Errorf(
+					"MATERIALIZED hints are not supported by the %s dialect",
+					c.dialect.Name(),
+				)
+			}
+			if hint == sst.NotMaterialized {
+				c.parts = append(c.parts, "NOT ")
+			}
+			c.parts = append(c.parts, "MATERIALIZED ")
+		}
+
+		if err := c.VisitExpressionGroupStart(); err != nil {
+			return err
+		}
+		if err := cte.Query().Accept(c); err != nil {
+			return err
+		}
+		if err := c.VisitExpressionGroupEnd(); err != nil {
+			return err
+		}
+	}
+	c.parts = append(c.parts, " ")
+	return nil
+}
+
 // VisitClause renders a clause declaration.
 func (c *Compiler) VisitClause(clause sst.ClauseNode) error {
 	c.parts = append(c.parts, " ", clause.Declaration(), " ")
@@ -42,15 +178,415 @@ func (c *Compiler) VisitClause(clause sst.ClauseNode) error {
 }
 
 // VisitExpression renders the current expression node. Composite binary
-// expressions have already traversed their operands before this call.
+// expressions have already traversed their operands before this call. Bind
+// parameters are rendered with the dialect placeholder for their position,
+// cast to their type hint when one is set, and projection aliases are quoted
+// as identifiers. Named placeholders bind each name once: a repeated name
+// reuses its placeholder when the value is equal and is rejected otherwise,
+// so generated pN names cannot silently shadow user-chosen ones.
 func (c *Compiler) VisitExpression(expr sst.ExpressionNode) error {
-	if param, ok := expr.(sst.BindParamNode); ok {
-		c.args = append(c.args, param.Value())
+	switch e := expr.(type) {
+	case sst.BindParamNode:
+		if e.Type() != "" {
+			untyped := sst.NewBindParam(e.Value(), sst.WithBindName(e.Name()))
+			return sst.Cast(untyped, e.Type()).Accept(c)
+		}
+		placeholder, arg := c.dialect.Bind(len(c.args)+1, e.Name(), e.Value())
+		if named, ok := arg.(sql.NamedArg); ok {
+			value, seen := c.named[named.Name]
+			if seen && !reflect.DeepEqual(value, named.Value) {
+				return fmt.Errorf("bind name %q is used for different values", named.Name)
+			}
+			c.parts = append(c.parts, placeholder)
+			if seen {
+				return nil
+			}
+			if c.named == nil {
+				c.named = make(map[string]any)
+			}
+			c.named[named.Name] = named.Value
+			c.args = append(c.args, arg)
+			return nil
+		}
+		c.args = append(c.args, arg)
+		c.parts = append(c.parts, placeholder)
+		return nil
+	case sst.AliasedExpressionNode:
+		c.parts = append(c.parts, " AS ", c.dialect.QuoteIdentifier(e.Alias()))
+		return nil
+	case sst.InExpressionNode:
+		if values := e.Values(); values != nil && len(values.Items()) == 0 {
+			return c.visitEmptyIn(e)
+		}
+	case *sst.DefaultValue:
+		if !c.dialect.Supports(dialect.DefaultKeyword) {
+			return fmt.Errorf("DEFAULT values are not supported by the %s dialect", c.dialect.Name())
+		}
+	case *sst.ExcludedColumn:
+		return c.visitExcluded(e)
 	}
 	c.parts = append(c.parts, expr.Expr())
 	return nil
 }
 
+// visitEmptyIn renders an IN expression without candidates as a constant
+// predicate when the dialect allows it.
+func (c *Compiler) visitEmptyIn(e sst.InExpressionNode) error {
+	if !c.dialect.Supports(dialect.EmptyInList) {
+		return fmt.Errorf(
+			"%s list cannot be empty for the %s dialect",
+			strings.TrimSpace(e.Expr()), c.dialect.Name(),
+		)
+	}
+	if e.Operator() == sst.NotIn {
+		c.parts = append(c.parts, "1 = 1")
+		return nil
+	}
+	c.parts = append(c.parts, "1 = 0")
+	return nil
+}
+
+// VisitDerivedTable renders a subquery source as [LATERAL] (query) AS alias,
+// followed by its optional column list.
+func (c *Compiler) VisitDerivedTable(d sst.DerivedTableNode) error {
+	switch d.Query().(type) {
+	case sst.SelectStatementNode, sst.SetOperationNode:
+	case nil:
+		return fmt.Errorf("derived table %q requires a query", d.Alias())
+	default:
+		return fmt.Errorf("derived table %q must be a SELECT or set operation", d.Alias())
+	}
+	if d.Lateral() {
+		if !c.dialect.Supports(dialect.Lateral) {
+			return fmt.Errorf("LATERAL is not supported by the %s dialect", c.dialect.Name())
+		}
+		c.parts = append(c.parts, "LATERAL ")
+	}
+
+	if err := c.VisitExpressionGroupStart(); err != nil {
+		return err
+	}
+	if err := d.Query().Accept(c); err != nil {
+		return err
+	}
+	if err := c.VisitExpressionGroupEnd(); err != nil {
+		return err
+	}
+
+	c.parts = append(c.parts, " AS ", c.dialect.QuoteIdentifier(d.Alias()))
+	if columns := d.Columns(); len(columns) > 0 {
+		c.parts = append(c.parts, " (", c.identifierList(columns), ")")
+	}
+	return nil
+}
+
+// VisitDistinct renders DISTINCT after the SELECT keyword, or DISTINCT ON
+// (...) for dialects that support it. DISTINCT ON expressions must match the
+// leading ORDER BY terms in any order, as the database requires.
+func (c *Compiler) VisitDistinct(d sst.DistinctClauseNode) error {
+	if c.emulatesNullsOrdering(d.Ordering()) {
+		return fmt.Errorf(
+			"NULLS FIRST/LAST with SELECT DISTINCT is not supported by the %s dialect",
+			c.dialect.Name(),
+		)
+	}
+	on := d.On()
+	if on == nil {
+		c.parts = append(c.parts, "DISTINCT ")
+		return nil
+	}
+	if !c.dialect.Supports(dialect.DistinctOn) {
+		return fmt.Errorf("DISTINCT ON is not supported by the %s dialect", c.dialect.Name())
+	}
+	if ordering := d.Ordering(); ordering != nil {
+		exprs, terms := on.Items(), ordering.Items()
+		for i := 0; i < len(terms) && i < len(exprs); i++ {
+			if !containsExpression(exprs, terms[i]) {
+				return errors.New("DISTINCT ON expressions must match the leading ORDER BY terms")
+			}
+		}
+	}
+
+	c.parts = append(c.parts, "DISTINCT ON (")
+	if err := on.Accept(c); err != nil {
+		return err
+	}
+	c.parts = append(c.parts, ") ")
+	return nil
+}
+
+// containsExpression reports whether exprs holds an expression structurally
+// equal to the expression sorted by term.
+func containsExpression(exprs []sst.ExpressionNode, term sst.ExpressionNode) bool {
+	if ordering, ok := term.(sst.OrderingNode); ok {
+		term = ordering.Expression()
+	}
+	for _, expr := range exprs {
+		if reflect.DeepEqual(expr, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// VisitDistinctFrom renders a null-safe comparison. Dialects without native
+// IS [NOT] DISTINCT FROM use <=> when available and otherwise a grouped
+// expansion built from comparisons and null tests; the expansion repeats the
+// operands, so their bind arguments are collected once per occurrence.
+func (c *Compiler) VisitDistinctFrom(e sst.DistinctFromExpressionNode) error {
+	switch {
+	case c.dialect.Supports(dialect.IsDistinctFrom):
+		if err := sst.AcceptComparisonOperand(c, e.Left()); err != nil {
+			return err
+		}
+		c.parts = append(c.parts, e.Expr())
+		return sst.AcceptComparisonOperand(c, e.Right())
+	case c.dialect.Supports(dialect.NullSafeEqual):
+		if e.Operator() == sst.IsDistinctFrom {
+			c.parts = append(c.parts, "NOT (")
+		}
+		if err := sst.AcceptComparisonOperand(c, e.Left()); err != nil {
+			return err
+		}
+		c.parts = append(c.parts, " <=> ")
+		if err := sst.AcceptComparisonOperand(c, e.Right()); err != nil {
+			return err
+		}
+		if e.Operator() == sst.IsDistinctFrom {
+			c.parts = append(c.parts, ")")
+		}
+		return nil
+	default:
+		return c.visitDistinctFromExpansion(e)
+	}
+}
+
+// visitDistinctFromExpansion renders IS DISTINCT FROM as
+// (l <> r OR l IS NULL OR r IS NULL) AND NOT (l IS NULL AND r IS NULL),
+// negated for IS NOT DISTINCT FROM.
+func (c *Compiler) visitDistinctFromExpansion(e sst.DistinctFromExpressionNode) error {
+	left, right := e.Left(), e.Right()
+	var expansion sst.ExpressionNode = sst.And(
+		sst.Or(
+			sst.NewBinaryExpression(left, right, sst.NotEqual),
+			sst.IsNullExpr(left),
+			sst.IsNullExpr(right),
+		),
+		sst.Not(sst.And(sst.IsNullExpr(left), sst.IsNullExpr(right))),
+	)
+	if e.Operator() == sst.IsNotDistinctFrom {
+		expansion = sst.Not(expansion)
+	}
+
+	if err := c.VisitExpressionGroupStart(); err != nil {
+		return err
+	}
+	if err := expansion.Accept(c); err != nil {
+		return err
+	}
+	return c.VisitExpressionGroupEnd()
+}
+
+// VisitCast renders a type conversion as CAST(operand AS type), or with the
+// :: shorthand for dialects that provide it. The shorthand binds tighter than
+// any operator, so non-atomic operands are grouped.
+func (c *Compiler) VisitCast(e sst.CastExpressionNode) error {
+	if c.dialect.Supports(dialect.CastOperator) {
+		if err := sst.AcceptCastOperand(c, e.Operand()); err != nil {
+			return err
+		}
+		c.parts = append(c.parts, "::", e.Type())
+		return nil
+	}
+	c.parts = append(c.parts, "CAST(")
+	if err := e.Operand().Accept(c); err != nil {
+		return err
+	}
+	c.parts = append(c.parts, " AS ", e.Type(), ")")
+	return nil
+}
+
+// VisitConcat renders a string concatenation with the || operator, or as a
+// CONCAT(...) call for dialects without it.
+func (c *Compiler) VisitConcat(e sst.ConcatExpressionNode) error {
+	if !c.dialect.Supports(dialect.ConcatOperator) {
+		return sst.Func("CONCAT", e.Operands()...).Accept(c)
+	}
+	for i, operand := range e.Operands() {
+		if i > 0 {
+			c.parts = append(c.parts, e.Expr())
+		}
+		if err := sst.AcceptConcatOperand(c, operand); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VisitPattern renders a LIKE pattern match. Case-insensitive matches use
+// ILIKE when the dialect supports it and otherwise lower-case both operands.
+func (c *Compiler) VisitPattern(e sst.PatternExpressionNode) error {
+	native := c.dialect.Supports(dialect.ILike)
+	lowered := e.CaseInsensitive() && !native
+
+	if err := c.visitPatternOperand(e.Subject(), lowered); err != nil {
+		return err
+	}
+	switch {
+	case e.CaseInsensitive() && native && e.Operator() == sst.NotLike:
+		c.parts = append(c.parts, " NOT ILIKE ")
+	case e.CaseInsensitive() && native:
+		c.parts = append(c.parts, " ILIKE ")
+	default:
+		c.parts = append(c.parts, e.Expr())
+	}
+	if err := c.visitPatternOperand(e.Pattern(), lowered); err != nil {
+		return err
+	}
+	if escape := e.Escape(); escape != 0 {
+		c.parts = append(c.parts, " ESCAPE ", c.stringLiteral(string(escape)))
+	}
+	return nil
+}
+
+func (c *Compiler) visitPatternOperand(operand sst.ExpressionNode, lowered bool) error {
+	if !lowered {
+		return sst.AcceptComparisonOperand(c, operand)
+	}
+	c.parts = append(c.parts, "LOWER(")
+	if err := operand.Accept(c); err != nil {
+		return err
+	}
+	c.parts = append(c.parts, ")")
+	return nil
+}
+
+// stringLiteral renders value as a quoted SQL string literal for the dialect.
+func (c *Compiler) stringLiteral(value string) string {
+	if c.dialect.Supports(dialect.BackslashEscapes) {
+		value = strings.ReplaceAll(value, `\`, `\\`)
+	}
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// VisitOrdering renders an ORDER BY term. Dialects without native NULLS
+// FIRST or NULLS LAST get a leading CASE term that sorts NULL values into
+// place; it repeats the expression, so its bind arguments are collected once
+// per occurrence. Set operations and SELECT DISTINCT reject the emulation
+// before their ORDER BY is rendered.
+func (c *Compiler) VisitOrdering(o sst.OrderingNode) error {
+	expr := o.Expression()
+	nulls := o.Nulls()
+	if nulls != sst.NullsDefault && !c.dialect.Supports(dialect.NullsOrdering) {
+		first, rest := sst.NewLiteral(1), sst.NewLiteral(0)
+		if nulls == sst.NullsFirst {
+			first, rest = rest, first
+		}
+		placement := sst.Case().When(sst.IsNullExpr(expr), first).Else(rest)
+		if err := placement.Accept(c); err != nil {
+			return err
+		}
+		c.parts = append(c.parts, ", ")
+	}
+
+	if err := expr.Accept(c); err != nil {
+		return err
+	}
+	c.parts = append(c.parts, o.Expr())
+
+	if c.dialect.Supports(dialect.NullsOrdering) {
+		switch nulls {
+		case sst.NullsFirst:
+			c.parts = append(c.parts, " NULLS FIRST")
+		case sst.NullsLast:
+			c.parts = append(c.parts, " NULLS LAST")
+		}
+	}
+	return nil
+}
+
+// emulatesNullsOrdering reports whether ordering requests a NULL placement
+// that the dialect can only emulate with an extra CASE sort key. Databases
+// reject such keys where ORDER BY is limited to the select list, as in set
+// operations and SELECT DISTINCT.
+func (c *Compiler) emulatesNullsOrdering(ordering *sst.ExpressionList) bool {
+	if ordering == nil || c.dialect.Supports(dialect.NullsOrdering) {
+		return false
+	}
+	for _, term := range ordering.Items() {
+		if o, ok := term.(sst.OrderingNode); ok && o.Nulls() != sst.NullsDefault {
+			return true
+		}
+	}
+	return false
+}
+
+// VisitLimit renders the row-limiting clause at the position its dialect
+// requires: LIMIT and OFFSET after ORDER BY, TOP after the SELECT keyword for
+// unordered queries, or OFFSET ... FETCH NEXT after ORDER BY.
+func (c *Compiler) VisitLimit(l sst.LimitClauseNode, position sst.LimitPosition) error {
+	limit, offset := l.Limit(), l.Offset()
+	switch {
+	case c.dialect.Supports(dialect.LimitOffset):
+		if position != sst.LimitAfterOrdering {
+			return nil
+		}
+		if limit == nil && !c.dialect.Supports(dialect.StandaloneOffset) {
+			return fmt.Errorf("OFFSET requires LIMIT for the %s dialect", c.dialect.Name())
+		}
+		if limit != nil {
+			c.parts = append(c.parts, " LIMIT ")
+			if err := limit.Accept(c); err != nil {
+				return err
+			}
+		}
+		if offset != nil {
+			c.parts = append(c.parts, " OFFSET ")
+			return offset.Accept(c)
+		}
+		return nil
+	case c.dialect.Supports(dialect.Top) && !l.Ordered():
+		if offset != nil {
+			return fmt.Errorf("OFFSET requires ORDER BY for the %s dialect", c.dialect.Name())
+		}
+		if position != sst.LimitAfterDeclaration {
+			return nil
+		}
+		c.parts = append(c.parts, "TOP (")
+		if err := limit.Accept(c); err != nil {
+			return err
+		}
+		c.parts = append(c.parts, ") ")
+		return nil
+	case c.dialect.Supports(dialect.OffsetFetch):
+		if !l.Ordered() {
+			return fmt.Errorf("LIMIT and OFFSET require ORDER BY for the %s dialect", c.dialect.Name())
+		}
+		if position != sst.LimitAfterOrdering {
+			return nil
+		}
+		c.parts = append(c.parts, " OFFSET ")
+		if offset != nil {
+			if err := offset.Accept(c); err != nil {
+				return err
+			}
+		} else {
+			c.parts = append(c.parts, "0")
+		}
+		c.parts = append(c.parts, " ROWS")
+		if limit != nil {
+			c.parts = append(c.parts, " FETCH NEXT ")
+			if err := limit.Accept(c); err != nil {
+				return err
+			}
+			c.parts = append(c.parts, " ROWS ONLY")
+		}
+		return nil
+	default:
+		return fmt.Errorf("LIMIT and OFFSET are not supported by the %s dialect", c.dialect.Name())
+	}
+}
+
 // VisitExpressionGroupStart renders the opening parenthesis of a grouped
 // expression.
 func (c *Compiler) VisitExpressionGroupStart() error {
@@ -82,9 +618,289 @@ func (c *Compiler) VisitFromSource(source sst.FromSourceNode) error {
 	return nil
 }
 
+// VisitInsert renders INSERT INTO table [(columns)] followed by VALUES (...),
+// ... or by the source query, then the optional conflict and RETURNING
+// clauses. Bind arguments of the source query keep their rendering order.
+func (c *Compiler) VisitInsert(stmt sst.InsertStatementNode) error {
+	if err := c.VisitStatement(stmt); err != nil {
+		return err
+	}
+	rows := stmt.Rows()
+	query := stmt.Query()
+	if len(rows) == 0 && query == nil {
+		return errors.New("INSERT requires at least one VALUES row or a SELECT query")
+	}
+	if stmt.Table().Alias() != "" && !c.dialect.Supports(dialect.InsertTargetAlias) {
+		return fmt.Errorf("aliased INSERT targets are not supported by the %s dialect", c.dialect.Name())
+	}
+	if err := stmt.Table().Accept(c); err != nil {
+		return err
+	}
+	if columns := stmt.Columns(); len(columns) > 0 {
+		c.parts = append(c.parts, " (", c.identifierList(columns), ")")
+	}
+	if query != nil {
+		if stmt.ConflictClause() != nil && c.dialect.Supports(dialect.OnConflict) &&
+			!c.dialect.Supports(dialect.UnambiguousUpsertSelect) && endsWithFrom(query) {
+			return fmt.Errorf(
+				"ON CONFLICT after a SELECT source requires a WHERE clause in the query for the %s dialect",
+				c.dialect.Name(),
+			)
+		}
+		c.parts = append(c.parts, " ")
+		if err := query.Accept(c); err != nil {
+			return err
+		}
+		if err := c.visitConflict(stmt.ConflictClause()); err != nil {
+			return err
+		}
+		return c.visitReturning(stmt.ReturningClause())
+	}
+	c.parts = append(c.parts, " VALUES ")
+	for i, row := range rows {
+		if err := c.VisitListSeparator(i); err != nil {
+			return err
+		}
+		c.parts = append(c.parts, "(")
+		if err := row.Accept(c); err != nil {
+			return err
+		}
+		c.parts = append(c.parts, ")")
+	}
+	if err := c.visitConflict(stmt.ConflictClause()); err != nil {
+		return err
+	}
+	return c.visitReturning(stmt.ReturningClause())
+}
+
+// endsWithFrom reports whether the last SELECT of query has a FROM source but
+// no WHERE condition, so an ON keyword after it could continue a join.
+func endsWithFrom(query sst.StatementNode) bool {
+	switch q := query.(type) {
+	case sst.SelectStatementNode:
+		return q.Source() != nil && q.Condition() == nil
+	case sst.SetOperationNode:
+		return endsWithFrom(q.Right())
+	}
+	return false
+}
+
+// visitConflict renders the optional upsert clause as ON CONFLICT or as
+// ON DUPLICATE KEY UPDATE, depending on the dialect. ON DUPLICATE KEY UPDATE
+// has no target and no DO NOTHING form, so constraint targets and DO NOTHING
+// are rejected there, and target columns require the AnyUniqueKey opt-in.
+func (c *Compiler) visitConflict(conflict sst.ConflictClauseNode) error {
+	if conflict == nil {
+		return nil
+	}
+	assignments := conflict.Assignments()
+	if !conflict.DoesNothing() && len(assignments) == 0 {
+		return errors.New("ON CONFLICT requires a DO NOTHING or DO UPDATE action")
+	}
+	switch {
+	case c.dialect.Supports(dialect.OnConflict):
+		c.parts = append(c.parts, " ON CONFLICT")
+		columns := conflict.Columns()
+		switch {
+		case conflict.Constraint() != "":
+			if !c.dialect.Supports(dialect.ConflictConstraint) {
+				return fmt.Errorf("ON CONFLICT ON CONSTRAINT is not supported by the %s dialect", c.dialect.Name())
+			}
+			c.parts = append(c.parts, " ON CONSTRAINT ", c.dialect.QuoteIdentifier(conflict.Constraint()))
+		case len(columns) > 0:
+			c.parts = append(c.parts, " (", c.identifierList(columns), ")")
+		case len(assignments) > 0:
+			return errors.New("ON CONFLICT DO UPDATE requires a conflict target")
+		}
+		if conflict.DoesNothing() {
+			c.parts = append(c.parts, " DO NOTHING")
+			return nil
+		}
+		c.parts = append(c.parts, " DO UPDATE SET ")
+	case c.dialect.Supports(dialect.OnDuplicateKeyUpdate):
+		switch {
+		case conflict.Constraint() != "":
+			return fmt.Errorf("ON CONFLICT ON CONSTRAINT is not supported by the %s dialect", c.dialect.Name())
+		case len(conflict.Columns()) > 0 && !conflict.AllowsAnyUniqueKey():
+			return fmt.Errorf(
+				"ON CONFLICT columns are not supported by the %s dialect without an explicit AnyUniqueKey opt-in",
+				c.dialect.Name(),
+			)
+		case conflict.DoesNothing():
+			return fmt.Errorf("ON CONFLICT DO NOTHING is not supported by the %s dialect", c.dialect.Name())
+		}
+		c.parts = append(c.parts, " ON DUPLICATE KEY UPDATE ")
+	default:
+		return fmt.Errorf("ON CONFLICT is not supported by the %s dialect", c.dialect.Name())
+	}
+
+	c.excluded = true
+	defer func() { c.excluded = false }()
+	return c.visitAssignments(assignments)
+}
+
+// visitExcluded renders a reference to the row proposed for insertion, which
+// is only in scope within the DO UPDATE SET action of an upsert.
+func (c *Compiler) visitExcluded(e *sst.ExcludedColumn) error {
+	if !c.excluded {
+		return fmt.Errorf("EXCLUDED column %q is only valid in DO UPDATE SET assignments", e.Column())
+	}
+	column := c.dialect.QuoteIdentifier(e.Column())
+	if c.dialect.Supports(dialect.OnConflict) {
+		c.parts = append(c.parts, "EXCLUDED.", column)
+		return nil
+	}
+	c.parts = append(c.parts, "VALUES(", column, ")")
+	return nil
+}
+
+// visitAssignments renders comma-separated column = value assignments.
+func (c *Compiler) visitAssignments(assignments []sst.Assignment) error {
+	for i, assignment := range assignments {
+		if err := c.VisitListSeparator(i); err != nil {
+			return err
+		}
+		c.parts = append(c.parts, c.dialect.QuoteIdentifier(assignment.Column), " = ")
+		if err := assignment.Value.Accept(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VisitUpdate renders UPDATE table SET column = value, ... followed by the
+// optional FROM sources, WHERE condition, and RETURNING clause. An UPDATE
+// without a WHERE condition is rejected unless it opted in to all rows.
+// Dialects without the TargetAlias capability name an aliased target by its
+// alias and declare it as the first FROM source.
+func (c *Compiler) VisitUpdate(stmt sst.UpdateStatementNode) error {
+	if err := c.VisitStatement(stmt); err != nil {
+		return err
+	}
+	assignments := stmt.Assignments()
+	if len(assignments) == 0 {
+		return errors.New("UPDATE requires at least one SET assignment")
+	}
+	condition := stmt.Condition()
+	if condition == nil && !stmt.AllowsAllRows() {
+		return errors.New("UPDATE without WHERE requires an explicit AllRows opt-in")
+	}
+	table := stmt.Table()
+	sources := stmt.Sources()
+	if table.Alias() != "" && !c.dialect.Supports(dialect.TargetAlias) {
+		if !c.dialect.Supports(dialect.UpdateFrom) {
+			return fmt.Errorf("aliased UPDATE targets are not supported by the %s dialect", c.dialect.Name())
+		}
+		c.parts = append(c.parts, c.dialect.QuoteIdentifier(table.Alias()))
+		sources = append([]sst.TableRefNode{table}, sources...)
+	} else if err := table.Accept(c); err != nil {
+		return err
+	}
+	c.parts = append(c.parts, " SET ")
+	if err := c.visitAssignments(assignments); err != nil {
+		return err
+	}
+	if len(sources) > 0 {
+		if !c.dialect.Supports(dialect.UpdateFrom) {
+			return fmt.Errorf("UPDATE ... FROM is not supported by the %s dialect", c.dialect.Name())
+		}
+		c.parts = append(c.parts, " FROM ")
+		if err := c.visitSources(sources); err != nil {
+			return err
+		}
+	}
+	if condition != nil {
+		c.parts = append(c.parts, " WHERE ")
+		if err := condition.Accept(c); err != nil {
+			return err
+		}
+	}
+	return c.visitReturning(stmt.ReturningClause())
+}
+
+// VisitDelete renders DELETE FROM table followed by the optional sources,
+// WHERE condition, and RETURNING clause. Sources render as PostgreSQL USING
+// or as the multi-table DELETE target FROM target, sources form, depending
+// on the dialect. The multi-table form also declares an aliased target on
+// dialects without the TargetAlias capability. A DELETE without a WHERE
+// condition is rejected unless it opted in to all rows.
+func (c *Compiler) VisitDelete(stmt sst.DeleteStatementNode) error {
+	if err := stmt.Err(); err != nil {
+		return err
+	}
+	condition := stmt.Condition()
+	if condition == nil && !stmt.AllowsAllRows() {
+		return errors.New("DELETE without WHERE requires an explicit AllRows opt-in")
+	}
+	table := stmt.Table()
+	sources := stmt.Sources()
+	targetAlias := table.Alias() == "" || c.dialect.Supports(dialect.TargetAlias)
+	switch {
+	case len(sources) == 0 && targetAlias, c.dialect.Supports(dialect.DeleteUsing):
+		c.parts = append(c.parts, stmt.Declaration(), " FROM ")
+		if err := table.Accept(c); err != nil {
+			return err
+		}
+		if len(sources) > 0 {
+			c.parts = append(c.parts, " USING ")
+			if err := c.visitSources(sources); err != nil {
+				return err
+			}
+		}
+	case c.dialect.Supports(dialect.MultiTableDelete):
+		target := table.Alias()
+		if target == "" {
+			target = table.Name()
+		}
+		c.parts = append(c.parts, stmt.Declaration(), " ", c.dialect.QuoteIdentifier(target), " FROM ")
+		if err := c.visitSources(append([]sst.TableRefNode{table}, sources...)); err != nil {
+			return err
+		}
+	case len(sources) == 0:
+		return fmt.Errorf("aliased DELETE targets are not supported by the %s dialect", c.dialect.Name())
+	default:
+		return fmt.Errorf("DELETE ... USING is not supported by the %s dialect", c.dialect.Name())
+	}
+	if condition != nil {
+		c.parts = append(c.parts, " WHERE ")
+		if err := condition.Accept(c); err != nil {
+			return err
+		}
+	}
+	return c.visitReturning(stmt.ReturningClause())
+}
+
+// visitSources renders a comma-separated list of table sources.
+func (c *Compiler) visitSources(sources []sst.TableRefNode) error {
+	for i, source := range sources {
+		if err := c.VisitListSeparator(i); err != nil {
+			return err
+		}
+		if err := source.Accept(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// visitReturning renders the optional RETURNING clause of a DML statement
+// when the dialect supports it.
+func (c *Compiler) visitReturning(returning sst.ReturningClauseNode) error {
+	if returning == nil {
+		return nil
+	}
+	if !c.dialect.Supports(dialect.Returning) {
+		return fmt.Errorf("RETURNING is not supported by the %s dialect", c.dialect.Name())
+	}
+	return returning.Accept(c)
+}
+
 // VisitJoin renders a JOIN relationship. Its Right source is the forward
 // traversal edge; Left is a back-reference and must not be traversed here.
 func (c *Compiler) VisitJoin(j sst.JoinNode) error {
+	if j.Type() == sst.FullOuterJoin && !c.dialect.Supports(dialect.FullOuterJoin) {
+		return fmt.Errorf("FULL OUTER JOIN is not supported by the %s dialect", c.dialect.Name())
+	}
 	c.parts = append(c.parts, " ", string(j.Type()), " ")
 
 	right := j.Right()
@@ -100,6 +916,9 @@ func (c *Compiler) VisitJoin(j sst.JoinNode) error {
 			return err
 		}
 	}
+	if using := j.Using(); len(using) > 0 {
+		c.parts = append(c.parts, " USING (", c.identifierList(using), ")")
+	}
 
 	if next := right.Join(); next != nil {
 		return next.Accept(c)
@@ -107,16 +926,17 @@ func (c *Compiler) VisitJoin(j sst.JoinNode) error {
 	return nil
 }
 
-// VisitColumnRef renders a qualified or unqualified SQL column reference.
+// VisitColumnRef renders a qualified or unqualified SQL column reference,
+// quoting each identifier part through the dialect.
 func (c *Compiler) VisitColumnRef(column sst.ColumnRefNode) error {
 	parts := make([]string, 0, 3)
 	if column.Schema() != "" {
-		parts = append(parts, column.Schema())
+		parts = append(parts, c.dialect.QuoteIdentifier(column.Schema()))
 	}
 	if column.Table() != "" {
-		parts = append(parts, column.Table())
+		parts = append(parts, c.dialect.QuoteIdentifier(column.Table()))
 	}
-	parts = append(parts, column.Name())
+	parts = append(parts, c.dialect.QuoteIdentifier(column.Name()))
 	c.parts = append(c.parts, strings.Join(parts, "."))
 	return nil
 }
@@ -129,13 +949,27 @@ func (c *Compiler) VisitListSeparator(index int) error {
 	return nil
 }
 
-// VisitTableRef renders a qualified or unqualified SQL table reference.
+// identifierList quotes each name through the dialect and joins them with
+// commas, as in a column list.
+func (c *Compiler) identifierList(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = c.dialect.QuoteIdentifier(name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// VisitTableRef renders a qualified or unqualified SQL table reference and its
+// optional alias, quoting each identifier through the dialect.
 func (c *Compiler) VisitTableRef(table sst.TableRefNode) error {
 	parts := make([]string, 0, 2)
 	if table.Schema() != "" {
-		parts = append(parts, table.Schema())
+		parts = append(parts, c.dialect.QuoteIdentifier(table.Schema()))
 	}
-	parts = append(parts, table.Name())
+	parts = append(parts, c.dialect.QuoteIdentifier(table.Name()))
 	c.parts = append(c.parts, strings.Join(parts, "."))
+	if table.Alias() != "" {
+		c.parts = append(c.parts, " AS ", c.dialect.QuoteIdentifier(table.Alias()))
+	}
 	return nil
 }
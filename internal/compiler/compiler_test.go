@@ -1,8 +1,11 @@
 package compiler
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/candango/sqlok/internal/dialect"
+	"github.com/candango/sqlok/internal/schema"
 	"github.com/candango/sqlok/internal/sst"
 	"github.com/candango/sqlok/internal/sst/dql"
 	"github.com/stretchr/testify/assert"
@@ -224,6 +227,58 @@ func TestCompileSelectWithFromAndJoin(t *testing.T) {
 		assert.Empty(t, args)
 	})
 
+	t.Run("should have a full outer join", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).
+			From(sst.NewTableRef("users")).
+			FullOuterJoin(sst.NewTableRef("orders")).
+			On(sst.Eq(
+				sst.NewColumnRef("users", "id"),
+				sst.NewColumnRef("orders", "user_id"),
+			))
+
+		sql, args, err := Compile(stmt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT users.id FROM users FULL OUTER JOIN orders ON users.id = orders.user_id", sql)
+		assert.Empty(t, args)
+
+		_, _, err = CompileWith(stmt, dialect.MySQL())
+
+		assert.EqualError(t, err, "FULL OUTER JOIN is not supported by the mysql dialect")
+	})
+
+	t.Run("should have a natural join", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).
+			From(sst.NewTableRef("users")).
+			NaturalJoin(sst.NewTableRef("profiles"))
+		sql, args, err := Compile(stmt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT users.id FROM users NATURAL JOIN profiles", sql)
+		assert.Empty(t, args)
+	})
+
+	t.Run("should have joins with USING columns", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.NewColumnRef("", "id"),
+		).
+			From(sst.NewTableRef("users")).
+			Join(sst.NewTableRef("profiles")).
+			Using("id").
+			LeftJoin(sst.NewTableRef("settings")).
+			Using("id", "tenant_id")
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL())
+
+		assert.NoError(t, err)
+		assert.Equal(t, `SELECT "id" FROM "users" JOIN "profiles" USING ("id") `+
+			`LEFT JOIN "settings" USING ("id", "tenant_id")`, sql)
+		assert.Empty(t, args)
+	})
+
 	t.Run("should have an explicit left join", func(t *testing.T) {
 		stmt := dql.Select(
 			sst.NewColumnRef("users", "id"),
@@ -320,3 +375,1523 @@ func TestCompileRejectSelectBuilderError(t *testing.T) {
 
 	assert.EqualError(t, err, "JOIN requires a FROM source")
 }
+
+func TestCompileSelectWithIn(t *testing.T) {
+	t.Run("should expand a slice bind param into placeholders", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).From(
+			sst.NewTableRef("users"),
+		).Where(sst.And(
+			sst.Eq(sst.NewColumnRef("users", "active"), sst.NewBindParam(true)),
+			sst.InList(sst.NewColumnRef("users", "id"), sst.NewBindParam([]int{1, 2, 3})),
+		))
+
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT users.id FROM users WHERE users.active = $1 AND users.id IN ($2, $3, $4)", sql)
+		assert.Equal(t, []any{true, 1, 2, 3}, args)
+	})
+
+	t.Run("should keep the type hint of an expanded slice bind param", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).From(
+			sst.NewTableRef("users"),
+		).Where(
+			sst.InList(sst.NewColumnRef("users", "id"), sst.NewBindParam([]int{1, 2}, sst.WithBindType("int"))),
+		)
+
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT users.id FROM users WHERE users.id IN ($1::int, $2::int)", sql)
+		assert.Equal(t, []any{1, 2}, args)
+
+		sql, _, err = Compile(stmt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT users.id FROM users WHERE users.id IN (CAST(? AS int), CAST(? AS int))", sql)
+	})
+
+	t.Run("should render NOT IN with mixed expressions", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).From(
+			sst.NewTableRef("users"),
+		).Where(
+			sst.NotInList(
+				sst.NewColumnRef("users", "role"),
+				sst.NewBindParam("admin"),
+				sst.NewLiteral("'guest'"),
+			),
+		)
+
+		sql, args, err := Compile(stmt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT users.id FROM users WHERE users.role NOT IN (?, 'guest')", sql)
+		assert.Equal(t, []any{"admin"}, args)
+	})
+
+	t.Run("should render a subquery and keep argument order", func(t *testing.T) {
+		sub := dql.Select(
+			sst.NewColumnRef("orders", "user_id"),
+		).From(
+			sst.NewTableRef("orders"),
+		).Where(
+			sst.Gt(sst.NewColumnRef("orders", "total"), sst.NewBindParam(100)),
+		)
+		stmt := dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).From(
+			sst.NewTableRef("users"),
+		).Where(sst.And(
+			sst.InSubquery(sst.NewColumnRef("users", "id"), sub),
+			sst.Eq(sst.NewColumnRef("users", "active"), sst.NewBindParam(true)),
+		))
+
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		expected := "SELECT users.id FROM users " +
+			"WHERE users.id IN (SELECT orders.user_id FROM orders WHERE orders.total > $1) " +
+			"AND users.active = $2"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{100, true}, args)
+	})
+
+	t.Run("should reject a subquery construction error", func(t *testing.T) {
+		sub := dql.Select(
+			sst.NewColumnRef("orders", "user_id"),
+		).Join(sst.NewTableRef("orders"))
+		stmt := dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).From(
+			sst.NewTableRef("users"),
+		).Where(
+			sst.NotInSubquery(sst.NewColumnRef("users", "id"), sub),
+		)
+
+		_, _, err := Compile(stmt)
+
+		assert.EqualError(t, err, "JOIN requires a FROM source")
+	})
+}
+
+func TestCompileSelectWithEmptyIn(t *testing.T) {
+	stmt := func(values ...sst.ExpressionNode) sst.StatementNode {
+		return dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).From(
+			sst.NewTableRef("users"),
+		).Where(sst.Or(
+			sst.InList(sst.NewColumnRef("users", "id"), values...),
+			sst.NotInList(sst.NewColumnRef("users", "id"), values...),
+		))
+	}
+
+	t.Run("should compile to constant predicates", func(t *testing.T) {
+		sql, args, err := Compile(stmt(sst.NewBindParam([]int{})))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT users.id FROM users WHERE 1 = 0 OR 1 = 1", sql)
+		assert.Empty(t, args)
+	})
+
+	t.Run("should be rejected by a strict dialect", func(t *testing.T) {
+		strict := dialect.Generic(dialect.WithoutCapabilities(dialect.EmptyInList))
+
+		_, _, err := CompileWith(stmt(), strict)
+
+		assert.EqualError(t, err, "IN list cannot be empty for the generic dialect")
+	})
+}
+
+func TestCompileSelectWithNullTests(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition sst.ExpressionNode
+		expected  string
+	}{
+		{
+			name:      "is null",
+			condition: sst.IsNullExpr(sst.NewColumnRef("users", "deleted_at")),
+			expected:  "SELECT users.id FROM users WHERE users.deleted_at IS NULL",
+		},
+		{
+			name: "is not null inside and",
+			condition: sst.And(
+				sst.IsNotNullExpr(sst.NewColumnRef("users", "email")),
+				sst.Eq(sst.NewColumnRef("users", "active"), sst.NewBindParam(true)),
+			),
+			expected: "SELECT users.id FROM users WHERE users.email IS NOT NULL AND users.active = ?",
+		},
+		{
+			name:      "not groups a null test",
+			condition: sst.Not(sst.IsNullExpr(sst.NewColumnRef("users", "email"))),
+			expected:  "SELECT users.id FROM users WHERE NOT (users.email IS NULL)",
+		},
+		{
+			name: "null test groups a comparison operand",
+			condition: sst.IsNullExpr(
+				sst.Eq(sst.NewColumnRef("users", "active"), sst.NewBindParam(true)),
+			),
+			expected: "SELECT users.id FROM users WHERE (users.active = ?) IS NULL",
+		},
+		{
+			name: "or keeps null tests ungrouped",
+			condition: sst.And(
+				sst.Or(
+					sst.IsNullExpr(sst.NewColumnRef("users", "email")),
+					sst.IsNullExpr(sst.NewColumnRef("users", "phone")),
+				),
+				sst.Not(sst.Not(sst.IsNullExpr(sst.NewColumnRef("users", "name")))),
+			),
+			expected: "SELECT users.id FROM users " +
+				"WHERE (users.email IS NULL OR users.phone IS NULL) " +
+				"AND NOT NOT (users.name IS NULL)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmt := dql.Select(
+				sst.NewColumnRef("users", "id"),
+			).From(
+				sst.NewTableRef("users"),
+			).Where(tt.condition)
+
+			sql, _, err := Compile(stmt)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, sql)
+		})
+	}
+}
+
+func TestCompileSelectWithDistinctFrom(t *testing.T) {
+	stmt := func(condition sst.ExpressionNode) sst.StatementNode {
+		return dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).From(
+			sst.NewTableRef("users"),
+		).Where(sst.And(
+			condition,
+			sst.Eq(sst.NewColumnRef("users", "active"), sst.NewBindParam(true)),
+		))
+	}
+	distinct := sst.DistinctFrom(sst.NewColumnRef("users", "email"), sst.NewBindParam("a@b.c"))
+	notDistinct := sst.NotDistinctFrom(sst.NewColumnRef("users", "email"), sst.NewBindParam("a@b.c"))
+
+	tests := []struct {
+		name      string
+		dialect   dialect.Dialect
+		condition sst.ExpressionNode
+		expected  string
+		args      []any
+	}{
+		{
+			name:      "native distinct",
+			dialect:   dialect.PostgreSQL(dialect.WithQuotePolicy(dialect.QuoteWhenNeeded)),
+			condition: distinct,
+			expected:  "SELECT users.id FROM users WHERE users.email IS DISTINCT FROM $1 AND users.active = $2",
+			args:      []any{"a@b.c", true},
+		},
+		{
+			name:      "native not distinct",
+			dialect:   dialect.Generic(),
+			condition: notDistinct,
+			expected:  "SELECT users.id FROM users WHERE users.email IS NOT DISTINCT FROM ? AND users.active = ?",
+			args:      []any{"a@b.c", true},
+		},
+		{
+			name:      "mysql distinct",
+			dialect:   dialect.MySQL(dialect.WithQuotePolicy(dialect.QuoteWhenNeeded)),
+			condition: distinct,
+			expected:  "SELECT users.id FROM users WHERE NOT (users.email <=> ?) AND users.active = ?",
+			args:      []any{"a@b.c", true},
+		},
+		{
+			name:      "mysql not distinct",
+			dialect:   dialect.MySQL(dialect.WithQuotePolicy(dialect.QuoteWhenNeeded)),
+			condition: notDistinct,
+			expected:  "SELECT users.id FROM users WHERE users.email <=> ? AND users.active = ?",
+			args:      []any{"a@b.c", true},
+		},
+		{
+			name:      "expanded distinct",
+			dialect:   dialect.New("legacy"),
+			condition: distinct,
+			expected: "SELECT users.id FROM users " +
+				"WHERE ((users.email <> ? OR users.email IS NULL OR ? IS NULL) " +
+				"AND NOT (users.email IS NULL AND ? IS NULL)) AND users.active = ?",
+			args: []any{"a@b.c", "a@b.c", "a@b.c", true},
+		},
+		{
+			name:      "expanded not distinct",
+			dialect:   dialect.New("legacy"),
+			condition: notDistinct,
+			expected: "SELECT users.id FROM users " +
+				"WHERE (NOT ((users.email <> ? OR users.email IS NULL OR ? IS NULL) " +
+				"AND NOT (users.email IS NULL AND ? IS NULL))) AND users.active = ?",
+			args: []any{"a@b.c", "a@b.c", "a@b.c", true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := CompileWith(stmt(tt.condition), tt.dialect)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, sql)
+			assert.Equal(t, tt.args, args)
+		})
+	}
+}
+
+func TestCompileSelectWithRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition sst.ExpressionNode
+		expected  string
+		args      []any
+	}{
+		{
+			name: "between bind params",
+			condition: sst.BetweenExpr(
+				sst.NewColumnRef("orders", "created_at"),
+				sst.NewBindParam("2026-01-01"),
+				sst.NewBindParam("2026-02-01"),
+			),
+			expected: "SELECT orders.id FROM orders WHERE orders.created_at BETWEEN $1 AND $2",
+			args:     []any{"2026-01-01", "2026-02-01"},
+		},
+		{
+			name: "not between inside and",
+			condition: sst.And(
+				sst.Eq(sst.NewColumnRef("orders", "status"), sst.NewBindParam("paid")),
+				sst.NotBetweenExpr(
+					sst.NewColumnRef("orders", "total"),
+					sst.NewLiteral(10),
+					sst.NewBindParam(100),
+				),
+			),
+			expected: "SELECT orders.id FROM orders WHERE orders.status = $1 AND orders.total NOT BETWEEN 10 AND $2",
+			args:     []any{"paid", 100},
+		},
+		{
+			name: "or groups ranges only when needed",
+			condition: sst.Or(
+				sst.BetweenExpr(
+					sst.NewColumnRef("orders", "total"),
+					sst.NewBindParam(1),
+					sst.NewBindParam(2),
+				),
+				sst.Not(sst.BetweenExpr(
+					sst.NewColumnRef("orders", "total"),
+					sst.NewBindParam(5),
+					sst.NewBindParam(6),
+				)),
+			),
+			expected: "SELECT orders.id FROM orders WHERE orders.total BETWEEN $1 AND $2 OR NOT (orders.total BETWEEN $3 AND $4)",
+			args:     []any{1, 2, 5, 6},
+		},
+		{
+			name: "bounds group logical expressions",
+			condition: sst.BetweenExpr(
+				sst.Eq(sst.NewColumnRef("orders", "paid"), sst.NewBindParam(true)),
+				sst.And(sst.NewLiteral("FALSE"), sst.NewLiteral("TRUE")),
+				sst.NewLiteral("TRUE"),
+			),
+			expected: "SELECT orders.id FROM orders WHERE (orders.paid = $1) BETWEEN (FALSE AND TRUE) AND TRUE",
+			args:     []any{true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmt := dql.Select(
+				sst.NewColumnRef("orders", "id"),
+			).From(
+				sst.NewTableRef("orders"),
+			).Where(tt.condition)
+
+			sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+				dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+			))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, sql)
+			assert.Equal(t, tt.args, args)
+		})
+	}
+}
+
+func TestCompileSelectRejectsIncompleteRange(t *testing.T) {
+	stmt := dql.Select(
+		sst.NewColumnRef("orders", "id"),
+	).Where(sst.BetweenExpr(sst.NewColumnRef("orders", "total"), sst.NewBindParam(1), nil))
+
+	_, _, err := Compile(stmt)
+
+	assert.EqualError(t, err, "BETWEEN requires a subject, a lower bound, and an upper bound")
+}
+
+func TestCompileSelectWithPattern(t *testing.T) {
+	name := sst.NewColumnRef("users", "name")
+	email := sst.NewColumnRef("users", "email")
+
+	tests := []struct {
+		name      string
+		dialect   dialect.Dialect
+		condition sst.ExpressionNode
+		expected  string
+		args      []any
+	}{
+		{
+			name:      "like",
+			dialect:   dialect.Generic(),
+			condition: sst.LikeExpr(name, sst.NewBindParam("san%")),
+			expected:  "SELECT users.id FROM users WHERE users.name LIKE ?",
+			args:      []any{"san%"},
+		},
+		{
+			name:      "not like with escape",
+			dialect:   dialect.Generic(),
+			condition: sst.NotLikeExpr(name, sst.NewBindParam("a!%%"), sst.WithEscape('!')),
+			expected:  "SELECT users.id FROM users WHERE users.name NOT LIKE ? ESCAPE '!'",
+			args:      []any{"a!%%"},
+		},
+		{
+			name:      "contains escapes wildcards",
+			dialect:   dialect.PostgreSQL(dialect.WithQuotePolicy(dialect.QuoteWhenNeeded)),
+			condition: sst.Contains(name, "100%_sure"),
+			expected:  `SELECT users.id FROM users WHERE users.name LIKE $1 ESCAPE '\'`,
+			args:      []any{`%100\%\_sure%`},
+		},
+		{
+			name:      "contains escapes with a declared escape",
+			dialect:   dialect.PostgreSQL(dialect.WithQuotePolicy(dialect.QuoteWhenNeeded)),
+			condition: sst.Contains(name, "50%_x", sst.WithEscape('!')),
+			expected:  "SELECT users.id FROM users WHERE users.name LIKE $1 ESCAPE '!'",
+			args:      []any{"%50!%!_x%"},
+		},
+		{
+			name:      "sqlserver escapes character classes",
+			dialect:   dialect.SQLServer(),
+			condition: sst.Contains(name, "[a]"),
+			expected:  `SELECT [users].[id] FROM [users] WHERE [users].[name] LIKE @p1 ESCAPE '\'`,
+			args:      []any{`%\[a]%`},
+		},
+		{
+			name:      "mysql doubles the backslash escape literal",
+			dialect:   dialect.MySQL(dialect.WithQuotePolicy(dialect.QuoteWhenNeeded)),
+			condition: sst.StartsWith(name, "san"),
+			expected:  `SELECT users.id FROM users WHERE users.name LIKE ? ESCAPE '\\'`,
+			args:      []any{"san%"},
+		},
+		{
+			name:    "postgresql uses ilike",
+			dialect: dialect.PostgreSQL(dialect.WithQuotePolicy(dialect.QuoteWhenNeeded)),
+			condition: sst.And(
+				sst.EndsWith(email, "@example.com", sst.WithCaseInsensitive()),
+				sst.NotLikeExpr(name, sst.NewBindParam("test%"), sst.WithCaseInsensitive()),
+			),
+			expected: `SELECT users.id FROM users WHERE users.email ILIKE $1 ESCAPE '\' ` +
+				"AND users.name NOT ILIKE $2",
+			args: []any{"%@example.com", "test%"},
+		},
+		{
+			name:      "sqlite lowers both operands",
+			dialect:   dialect.SQLite(dialect.WithQuotePolicy(dialect.QuoteWhenNeeded)),
+			condition: sst.ILikeExpr(email, sst.NewBindParam("%@EXAMPLE.COM")),
+			expected:  "SELECT users.id FROM users WHERE LOWER(users.email) LIKE LOWER(?)",
+			args:      []any{"%@EXAMPLE.COM"},
+		},
+		{
+			name:      "not groups a pattern match",
+			dialect:   dialect.Generic(),
+			condition: sst.Not(sst.LikeExpr(name, sst.NewBindParam("%x"))),
+			expected:  "SELECT users.id FROM users WHERE NOT (users.name LIKE ?)",
+			args:      []any{"%x"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmt := dql.Select(
+				sst.NewColumnRef("users", "id"),
+			).From(
+				sst.NewTableRef("users"),
+			).Where(tt.condition)
+
+			sql, args, err := CompileWith(stmt, tt.dialect)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, sql)
+			assert.Equal(t, tt.args, args)
+		})
+	}
+}
+
+func TestCompileSelectWithExists(t *testing.T) {
+	t.Run("should merge correlated subquery arguments in order", func(t *testing.T) {
+		orders := dql.Select(
+			sst.NewLiteral(1),
+		).From(
+			sst.NewTableRef("orders"),
+		).Where(sst.And(
+			sst.Eq(sst.NewColumnRef("orders", "user_id"), sst.NewColumnRef("users", "id")),
+			sst.Gt(sst.NewColumnRef("orders", "total"), sst.NewBindParam(100)),
+		))
+		stmt := dql.Select(
+			sst.NewColumnRef("users", "id"),
+			sst.NewBindParam("first"),
+		).From(
+			sst.NewTableRef("users"),
+		).Where(sst.And(
+			sst.ExistsSubquery(orders),
+			sst.Eq(sst.NewColumnRef("users", "active"), sst.NewBindParam(true)),
+		))
+
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		expected := "SELECT users.id, $1 FROM users " +
+			"WHERE EXISTS (SELECT 1 FROM orders WHERE orders.user_id = users.id AND orders.total > $2) " +
+			"AND users.active = $3"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{"first", 100, true}, args)
+	})
+
+	t.Run("should render not exists and negated exists", func(t *testing.T) {
+		bans := dql.Select(
+			sst.NewLiteral(1),
+		).From(
+			sst.NewTableRef("bans"),
+		).Where(
+			sst.Eq(sst.NewColumnRef("bans", "user_id"), sst.NewColumnRef("users", "id")),
+		)
+		stmt := dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).From(
+			sst.NewTableRef("users"),
+		).Where(sst.Or(
+			sst.NotExistsSubquery(bans),
+			sst.Not(sst.ExistsSubquery(bans)),
+		))
+
+		sql, args, err := Compile(stmt)
+
+		expected := "SELECT users.id FROM users " +
+			"WHERE NOT EXISTS (SELECT 1 FROM bans WHERE bans.user_id = users.id) " +
+			"OR NOT EXISTS (SELECT 1 FROM bans WHERE bans.user_id = users.id)"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Empty(t, args)
+	})
+
+	t.Run("should reject a missing subquery", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).Where(sst.ExistsSubquery(nil))
+
+		_, _, err := Compile(stmt)
+
+		assert.EqualError(t, err, "EXISTS subquery cannot be nil")
+	})
+}
+
+func TestCompileSelectWithOrderBy(t *testing.T) {
+	t.Run("should render ordering terms in order", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).From(
+			sst.NewTableRef("users"),
+		).OrderBy(
+			sst.NewColumnRef("users", "name"),
+			sst.Desc(sst.NewColumnRef("users", "created_at"), sst.WithNullsLast()),
+		).OrderBy(
+			sst.Asc(sst.NewColumnRef("users", "email")),
+		)
+
+		sql, args, err := Compile(stmt)
+
+		expected := "SELECT users.id FROM users " +
+			"ORDER BY users.name, users.created_at DESC NULLS LAST, users.email ASC"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Empty(t, args)
+	})
+
+	t.Run("should emulate null placement without native support", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).From(
+			sst.NewTableRef("users"),
+		).OrderBy(
+			sst.Asc(sst.NewColumnRef("users", "deleted_at"), sst.WithNullsFirst()),
+			sst.Desc(sst.NewColumnRef("users", "score"), sst.WithNullsLast()),
+		)
+
+		sql, _, err := CompileWith(stmt, dialect.MySQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		expected := "SELECT users.id FROM users ORDER BY " +
+			"CASE WHEN users.deleted_at IS NULL THEN 0 ELSE 1 END, users.deleted_at ASC, " +
+			"CASE WHEN users.score IS NULL THEN 1 ELSE 0 END, users.score DESC"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+	})
+
+	t.Run("should reject null placement emulation limited to the select list", func(t *testing.T) {
+		id := sst.NewColumnRef("users", "id")
+		users := func() sst.SelectBuilder {
+			return dql.Select(id).From(sst.NewTableRef("users"))
+		}
+		nullsLast := sst.Desc(id, sst.WithNullsLast())
+
+		_, _, err := CompileWith(users().Distinct().OrderBy(nullsLast), dialect.MySQL())
+
+		assert.EqualError(t, err, "NULLS FIRST/LAST with SELECT DISTINCT is not supported by the mysql dialect")
+
+		_, _, err = CompileWith(dql.Union(users(), users()).OrderBy(nullsLast), dialect.SQLServer())
+
+		assert.EqualError(t, err, "NULLS FIRST/LAST on a set operation is not supported by the sqlserver dialect")
+
+		sql, _, err := CompileWith(users().Distinct().OrderBy(nullsLast), dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT DISTINCT users.id FROM users ORDER BY users.id DESC NULLS LAST", sql)
+
+		sql, _, err = CompileWith(users().Distinct().OrderBy(sst.Desc(id)), dialect.SQLServer())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT DISTINCT [users].[id] FROM [users] ORDER BY [users].[id] DESC", sql)
+	})
+
+	t.Run("should reject an empty ordering", func(t *testing.T) {
+		stmt := dql.Select(sst.NewColumnRef("users", "id")).OrderBy()
+
+		_, _, err := Compile(stmt)
+
+		assert.EqualError(t, err, "ORDER BY requires at least one expression")
+	})
+}
+
+func TestCompileSelectWithLimit(t *testing.T) {
+	ordered := func() sst.SelectBuilder {
+		return dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).From(
+			sst.NewTableRef("users"),
+		).Where(
+			sst.Eq(sst.NewColumnRef("users", "active"), sst.NewBindParam(true)),
+		).OrderBy(sst.NewColumnRef("users", "id"))
+	}
+	unordered := func() sst.SelectBuilder {
+		return dql.Select(
+			sst.NewColumnRef("users", "id"),
+		).From(
+			sst.NewTableRef("users"),
+		).Where(
+			sst.Eq(sst.NewColumnRef("users", "active"), sst.NewBindParam(true)),
+		)
+	}
+	whenNeeded := dialect.WithQuotePolicy(dialect.QuoteWhenNeeded)
+
+	tests := []struct {
+		name     string
+		stmt     sst.StatementNode
+		dialect  dialect.Dialect
+		expected string
+		args     []any
+	}{
+		{
+			name:    "generic limit and offset",
+			stmt:    ordered().Limit(10).Offset(20),
+			dialect: dialect.Generic(),
+			expected: "SELECT users.id FROM users WHERE users.active = ? " +
+				"ORDER BY users.id LIMIT ? OFFSET ?",
+			args: []any{true, 10, 20},
+		},
+		{
+			name:    "postgresql numbered placeholders",
+			stmt:    ordered().Limit(10).Offset(20),
+			dialect: dialect.PostgreSQL(whenNeeded),
+			expected: "SELECT users.id FROM users WHERE users.active = $1 " +
+				"ORDER BY users.id LIMIT $2 OFFSET $3",
+			args: []any{true, 10, 20},
+		},
+		{
+			name:     "postgresql standalone offset",
+			stmt:     unordered().Offset(5),
+			dialect:  dialect.PostgreSQL(whenNeeded),
+			expected: "SELECT users.id FROM users WHERE users.active = $1 OFFSET $2",
+			args:     []any{true, 5},
+		},
+		{
+			name:     "mysql limit without ordering",
+			stmt:     unordered().Limit(10),
+			dialect:  dialect.MySQL(whenNeeded),
+			expected: "SELECT users.id FROM users WHERE users.active = ? LIMIT ?",
+			args:     []any{true, 10},
+		},
+		{
+			name:     "sqlserver top",
+			stmt:     unordered().Limit(10),
+			dialect:  dialect.SQLServer(whenNeeded),
+			expected: "SELECT TOP (@p1) users.id FROM users WHERE users.active = @p2",
+			args:     []any{10, true},
+		},
+		{
+			name:    "sqlserver offset fetch",
+			stmt:    ordered().Limit(10).Offset(20),
+			dialect: dialect.SQLServer(whenNeeded),
+			expected: "SELECT users.id FROM users WHERE users.active = @p1 " +
+				"ORDER BY users.id OFFSET @p2 ROWS FETCH NEXT @p3 ROWS ONLY",
+			args: []any{true, 20, 10},
+		},
+		{
+			name:    "sqlserver fetch without offset",
+			stmt:    ordered().Limit(10),
+			dialect: dialect.SQLServer(whenNeeded),
+			expected: "SELECT users.id FROM users WHERE users.active = @p1 " +
+				"ORDER BY users.id OFFSET 0 ROWS FETCH NEXT @p2 ROWS ONLY",
+			args: []any{true, 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := CompileWith(tt.stmt, tt.dialect)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, sql)
+			assert.Equal(t, tt.args, args)
+		})
+	}
+
+	t.Run("should reject offset without limit for mysql", func(t *testing.T) {
+		_, _, err := CompileWith(unordered().Offset(5), dialect.MySQL())
+
+		assert.EqualError(t, err, "OFFSET requires LIMIT for the mysql dialect")
+	})
+
+	t.Run("should reject offset without ordering for sqlserver", func(t *testing.T) {
+		_, _, err := CompileWith(unordered().Limit(10).Offset(5), dialect.SQLServer())
+
+		assert.EqualError(t, err, "OFFSET requires ORDER BY for the sqlserver dialect")
+	})
+
+	t.Run("should reject limits for dialects without row limiting", func(t *testing.T) {
+		_, _, err := CompileWith(unordered().Limit(10), dialect.New("custom"))
+
+		assert.EqualError(t, err, "LIMIT and OFFSET are not supported by the custom dialect")
+	})
+}
+
+func TestCompileSelectWithAggregates(t *testing.T) {
+	t.Run("should render aggregate functions", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.CountAll(),
+			sst.Count(sst.NewColumnRef("orders", "id")),
+			sst.CountDistinct(sst.NewColumnRef("orders", "user_id")),
+			sst.Sum(sst.NewColumnRef("orders", "total"), sst.WithDistinct()),
+			sst.Avg(sst.NewColumnRef("orders", "total")),
+			sst.Min(sst.NewColumnRef("orders", "created_at")),
+			sst.Max(sst.NewColumnRef("orders", "created_at")),
+		).From(sst.NewTableRef("orders"))
+
+		sql, args, err := Compile(stmt)
+
+		expected := "SELECT COUNT(*), COUNT(orders.id), COUNT(DISTINCT orders.user_id), " +
+			"SUM(DISTINCT orders.total), AVG(orders.total), " +
+			"MIN(orders.created_at), MAX(orders.created_at) FROM orders"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Empty(t, args)
+	})
+
+	t.Run("should reject a missing argument", func(t *testing.T) {
+		stmt := dql.Select(sst.Sum(nil)).From(sst.NewTableRef("orders"))
+
+		_, _, err := Compile(stmt)
+
+		assert.EqualError(t, err, "SUM requires an argument")
+	})
+}
+
+func TestCompileSelectWithGroupBy(t *testing.T) {
+	t.Run("should render GROUP BY and HAVING in SQL order", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.NewColumnRef("orders", "user_id"),
+			sst.NewColumnRef("orders", "status"),
+			sst.Sum(sst.NewColumnRef("orders", "total")),
+		).From(
+			sst.NewTableRef("orders"),
+		).Where(
+			sst.Gt(sst.NewColumnRef("orders", "total"), sst.NewBindParam(0)),
+		).GroupBy(
+			sst.NewColumnRef("orders", "user_id"),
+		).GroupBy(
+			sst.NewColumnRef("orders", "status"),
+		).Having(
+			sst.Gt(sst.CountAll(), sst.NewBindParam(5)),
+		).Having(
+			sst.Gt(sst.Sum(sst.NewColumnRef("orders", "total")), sst.NewBindParam(1000)),
+		).OrderBy(
+			sst.Desc(sst.Sum(sst.NewColumnRef("orders", "total"))),
+		).Limit(10)
+
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		expected := "SELECT orders.user_id, orders.status, SUM(orders.total) FROM orders " +
+			"WHERE orders.total > $1 " +
+			"GROUP BY orders.user_id, orders.status " +
+			"HAVING COUNT(*) > $2 AND SUM(orders.total) > $3 " +
+			"ORDER BY SUM(orders.total) DESC LIMIT $4"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{0, 5, 1000, 10}, args)
+	})
+
+	t.Run("should reject an empty grouping", func(t *testing.T) {
+		stmt := dql.Select(sst.CountAll()).From(sst.NewTableRef("orders")).GroupBy()
+
+		_, _, err := Compile(stmt)
+
+		assert.EqualError(t, err, "GROUP BY requires at least one expression")
+	})
+
+	t.Run("should reject a nil HAVING condition", func(t *testing.T) {
+		stmt := dql.Select(sst.CountAll()).From(sst.NewTableRef("orders")).Having(nil)
+
+		_, _, err := Compile(stmt)
+
+		assert.EqualError(t, err, "HAVING condition cannot be nil")
+	})
+}
+
+func TestCompileSelectWithFunctionCalls(t *testing.T) {
+	t.Run("should render function calls in every clause", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.Coalesce(sst.NewColumnRef("users", "nickname"), sst.NewBindParam("anonymous")),
+			sst.Func("NOW"),
+		).From(
+			sst.NewTableRef("users"),
+		).Join(
+			sst.NewTableRef("emails"),
+		).On(
+			sst.Eq(sst.Lower(sst.NewColumnRef("emails", "address")), sst.Lower(sst.NewColumnRef("users", "email"))),
+		).Where(
+			sst.Eq(sst.Upper(sst.NewColumnRef("users", "country")), sst.NewBindParam("BR")),
+		).OrderBy(
+			sst.Desc(sst.Func("pg_catalog.length", sst.NewColumnRef("users", "name"))),
+		)
+
+		sql, args, err := Compile(stmt)
+
+		expected := "SELECT COALESCE(users.nickname, ?), NOW() FROM users " +
+			"JOIN emails ON LOWER(emails.address) = LOWER(users.email) " +
+			"WHERE UPPER(users.country) = ? " +
+			"ORDER BY pg_catalog.length(users.name) DESC"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{"anonymous", "BR"}, args)
+	})
+
+	t.Run("should reject unsafe function names", func(t *testing.T) {
+		for _, name := range []string{"", "now()", "1abs", "pg_catalog.", "a;b"} {
+			stmt := dql.Select(sst.Func(name))
+
+			_, _, err := Compile(stmt)
+
+			assert.EqualError(t, err, fmt.Sprintf("invalid function name %q", name))
+		}
+	})
+}
+
+func TestCompileSelectWithArithmetic(t *testing.T) {
+	price := sst.NewColumnRef("items", "price")
+	qty := sst.NewColumnRef("items", "qty")
+
+	tests := []struct {
+		name     string
+		expr     sst.ExpressionNode
+		expected string
+	}{
+		{
+			name:     "multiplication",
+			expr:     sst.Mul(price, qty),
+			expected: "items.price * items.qty",
+		},
+		{
+			name:     "lower precedence operand",
+			expr:     sst.Mul(sst.Add(price, sst.NewBindParam(1)), qty),
+			expected: "(items.price + ?) * items.qty",
+		},
+		{
+			name:     "higher precedence operand",
+			expr:     sst.Add(price, sst.Mul(qty, sst.NewBindParam(2))),
+			expected: "items.price + items.qty * ?",
+		},
+		{
+			name:     "left associative chain",
+			expr:     sst.Sub(sst.Sub(price, qty), sst.NewBindParam(3)),
+			expected: "items.price - items.qty - ?",
+		},
+		{
+			name:     "right operand with equal precedence",
+			expr:     sst.Div(price, sst.Mod(qty, sst.NewBindParam(4))),
+			expected: "items.price / (items.qty % ?)",
+		},
+		{
+			name:     "function argument",
+			expr:     sst.Coalesce(sst.Mul(price, qty), sst.NewBindParam(0)),
+			expected: "COALESCE(items.price * items.qty, ?)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, _, err := Compile(dql.Select(tt.expr))
+
+			assert.NoError(t, err)
+			assert.Equal(t, "SELECT "+tt.expected, sql)
+		})
+	}
+
+	t.Run("should compare computed values without grouping", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.As(sst.Mul(price, qty), "subtotal"),
+		).From(
+			sst.NewTableRef("items"),
+		).Where(sst.And(
+			sst.Gt(sst.Mul(price, qty), sst.NewBindParam(100)),
+			sst.Eq(sst.Mod(qty, sst.NewBindParam(2)), sst.NewLiteral(0)),
+		))
+
+		sql, args, err := Compile(stmt)
+
+		expected := "SELECT items.price * items.qty AS subtotal FROM items " +
+			"WHERE items.price * items.qty > ? AND items.qty % ? = 0"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{100, 2}, args)
+	})
+}
+
+func TestCompileSelectWithConcat(t *testing.T) {
+	first := sst.NewColumnRef("users", "first_name")
+	last := sst.NewColumnRef("users", "last_name")
+	stmt := dql.Select(
+		sst.As(sst.Concat(first, sst.NewBindParam(" "), last), "full_name"),
+	).From(
+		sst.NewTableRef("users"),
+	).Where(
+		sst.Eq(sst.Concat(first, sst.Concat(last, sst.NewLiteral("''"))), sst.NewBindParam("ab")),
+	)
+	whenNeeded := dialect.WithQuotePolicy(dialect.QuoteWhenNeeded)
+
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		expected string
+	}{
+		{
+			name:    "postgresql",
+			dialect: dialect.PostgreSQL(whenNeeded),
+			expected: "SELECT users.first_name || $1 || users.last_name AS full_name FROM users " +
+				"WHERE users.first_name || (users.last_name || '') = $2",
+		},
+		{
+			name:    "mysql",
+			dialect: dialect.MySQL(whenNeeded),
+			expected: "SELECT CONCAT(users.first_name, ?, users.last_name) AS full_name FROM users " +
+				"WHERE CONCAT(users.first_name, CONCAT(users.last_name, '')) = ?",
+		},
+		{
+			name:    "sqlserver",
+			dialect: dialect.SQLServer(whenNeeded),
+			expected: "SELECT CONCAT(users.first_name, @p1, users.last_name) AS full_name FROM users " +
+				"WHERE CONCAT(users.first_name, CONCAT(users.last_name, '')) = @p2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := CompileWith(stmt, tt.dialect)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, sql)
+			assert.Equal(t, []any{" ", "ab"}, args)
+		})
+	}
+
+	t.Run("should reject a single operand", func(t *testing.T) {
+		_, _, err := Compile(dql.Select(sst.Concat(first)))
+
+		assert.EqualError(t, err, "concatenation requires at least two expressions")
+	})
+}
+
+func TestCompileSelectWithCase(t *testing.T) {
+	t.Run("should render a searched case with binds in branch order", func(t *testing.T) {
+		total := sst.NewColumnRef("orders", "total")
+		stmt := dql.Select(
+			sst.As(sst.Case().
+				When(sst.Gt(total, sst.NewBindParam(1000)), sst.NewBindParam("large")).
+				When(sst.Gt(total, sst.NewBindParam(100)), sst.NewBindParam("medium")).
+				Else(sst.NewBindParam("small")), "size"),
+		).From(sst.NewTableRef("orders"))
+
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		expected := "SELECT CASE WHEN orders.total > $1 THEN $2 " +
+			"WHEN orders.total > $3 THEN $4 ELSE $5 END AS size FROM orders"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{1000, "large", 100, "medium", "small"}, args)
+	})
+
+	t.Run("should render a simple case without else", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.CaseOf(sst.NewColumnRef("users", "status")).
+				When(sst.NewBindParam("A"), sst.NewBindParam("active")).
+				When(sst.NewBindParam("I"), sst.NewBindParam("inactive")),
+		).From(sst.NewTableRef("users"))
+
+		sql, args, err := Compile(stmt)
+
+		expected := "SELECT CASE users.status WHEN ? THEN ? WHEN ? THEN ? END FROM users"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{"A", "active", "I", "inactive"}, args)
+	})
+
+	t.Run("should nest case inside logical and arithmetic expressions", func(t *testing.T) {
+		role := sst.NewColumnRef("users", "role")
+		inner := sst.Case().
+			When(sst.Eq(role, sst.NewBindParam("admin")), sst.NewLiteral(1)).
+			Else(sst.NewLiteral(0))
+		outer := sst.Case().
+			When(sst.Or(sst.IsNullExpr(role), sst.Eq(inner, sst.NewLiteral(1))), sst.NewBindParam(true)).
+			Else(sst.NewBindParam(false))
+		stmt := dql.Select(
+			sst.Mul(inner, sst.NewBindParam(10)),
+		).From(
+			sst.NewTableRef("users"),
+		).Where(sst.And(
+			sst.Or(sst.Eq(outer, sst.NewBindParam(true)), sst.IsNullExpr(role)),
+			sst.Not(inner),
+		))
+
+		sql, args, err := Compile(stmt)
+
+		expected := "SELECT CASE WHEN users.role = ? THEN 1 ELSE 0 END * ? FROM users " +
+			"WHERE (CASE WHEN users.role IS NULL OR CASE WHEN users.role = ? THEN 1 ELSE 0 END = 1 " +
+			"THEN ? ELSE ? END = ? OR users.role IS NULL) " +
+			"AND NOT CASE WHEN users.role = ? THEN 1 ELSE 0 END"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{"admin", 10, "admin", true, false, true, "admin"}, args)
+	})
+
+	t.Run("should reject a case without branches", func(t *testing.T) {
+		_, _, err := Compile(dql.Select(sst.Case().Else(sst.NewLiteral(0))))
+
+		assert.EqualError(t, err, "CASE requires at least one WHEN branch")
+	})
+}
+
+func TestCompileSelectWithCast(t *testing.T) {
+	price := sst.NewColumnRef("items", "price")
+	qty := sst.NewColumnRef("items", "qty")
+	field := &schema.Field{FieldName: "payload", Type: "jsonb"}
+	stmt := dql.Select(
+		sst.Cast(price, "numeric(10, 2)"),
+		sst.Cast(sst.Mul(price, qty), "int"),
+	).From(
+		sst.NewTableRef("items"),
+	).Where(sst.Or(
+		sst.IsNullExpr(sst.NewBindParam(nil, sst.WithBindType("int"))),
+		sst.Eq(sst.NewColumnRef("items", field.FieldName), sst.NewBindParam(`{}`, sst.WithFieldType(field))),
+	))
+
+	t.Run("should render the standard form", func(t *testing.T) {
+		sql, args, err := Compile(stmt)
+
+		expected := "SELECT CAST(items.price AS numeric(10, 2)), CAST(items.price * items.qty AS int) " +
+			"FROM items WHERE CAST(? AS int) IS NULL OR items.payload = CAST(? AS jsonb)"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{nil, `{}`}, args)
+	})
+
+	t.Run("should render the postgresql shorthand", func(t *testing.T) {
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		expected := "SELECT items.price::numeric(10, 2), (items.price * items.qty)::int " +
+			"FROM items WHERE $1::int IS NULL OR items.payload = $2::jsonb"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{nil, `{}`}, args)
+	})
+
+	t.Run("should reject unsafe type names", func(t *testing.T) {
+		for _, sqlType := range []string{
+			"", "int)", "varchar(10", "int; DROP TABLE users", "1int",
+			"int OR true", "text AND 1=1", "NOT", "int IS NULL", "numeric(10)(2)",
+			"numeric(a, 2)", "text UNION SELECT password FROM users", "int[] ",
+		} {
+			_, _, err := Compile(dql.Select(sst.Cast(price, sqlType)))
+
+			assert.Error(t, err, sqlType)
+		}
+
+		bind := sst.NewBindParam(1, sst.WithBindType("int OR true"))
+		_, _, err := CompileWith(
+			dql.Select(price).From(sst.NewTableRef("items")).Where(sst.Eq(price, bind)),
+			dialect.PostgreSQL(),
+		)
+
+		assert.EqualError(t, err, `invalid SQL type "int OR true"`)
+	})
+
+	t.Run("should accept multi-word and parameterized type names", func(t *testing.T) {
+		for _, sqlType := range []string{
+			"double precision", "character varying(255)", "timestamp(3) with time zone",
+			"varchar(max)", "numeric(10,2)", "int[]", "pg_catalog.int4", "unsigned integer",
+		} {
+			_, _, err := Compile(dql.Select(sst.Cast(price, sqlType)))
+
+			assert.NoError(t, err, sqlType)
+		}
+	})
+}
+
+func TestCompileSetOperations(t *testing.T) {
+	users := func(active bool) *dql.SelectStatement {
+		stmt := dql.Select(sst.NewColumnRef("users", "id"))
+		stmt.From(sst.NewTableRef("users")).
+			Where(sst.Eq(sst.NewColumnRef("users", "active"), sst.NewBindParam(active)))
+		return stmt
+	}
+	admins := func() *dql.SelectStatement {
+		stmt := dql.Select(sst.NewColumnRef("admins", "user_id"))
+		stmt.From(sst.NewTableRef("admins"))
+		return stmt
+	}
+	whenNeeded := dialect.WithQuotePolicy(dialect.QuoteWhenNeeded)
+
+	t.Run("should chain operators left to right with ordering and limit", func(t *testing.T) {
+		stmt := dql.Union(users(true), admins()).
+			Except(users(false)).
+			OrderBy(sst.Desc(sst.NewColumnRef("", "id"))).
+			Limit(10)
+
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(whenNeeded))
+
+		expected := "SELECT users.id FROM users WHERE users.active = $1 " +
+			"UNION SELECT admins.user_id FROM admins " +
+			"EXCEPT SELECT users.id FROM users WHERE users.active = $2 " +
+			"ORDER BY id DESC LIMIT $3"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{true, false, 10}, args)
+	})
+
+	t.Run("should group operands whose meaning requires it", func(t *testing.T) {
+		limited := users(true)
+		limited.OrderBy(sst.NewColumnRef("users", "id")).Limit(5)
+		stmt := dql.UnionAll(limited, admins()).
+			Intersect(dql.Except(admins(), users(false)))
+
+		sql, args, err := Compile(stmt)
+
+		expected := "((SELECT users.id FROM users WHERE users.active = ? ORDER BY users.id LIMIT ?) " +
+			"UNION ALL SELECT admins.user_id FROM admins) " +
+			"INTERSECT (SELECT admins.user_id FROM admins " +
+			"EXCEPT SELECT users.id FROM users WHERE users.active = ?)"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{true, 5, false}, args)
+	})
+
+	t.Run("should render sqlite chains flat", func(t *testing.T) {
+		stmt := dql.Union(users(true), admins()).Intersect(admins())
+
+		sql, _, err := CompileWith(stmt, dialect.SQLite(whenNeeded))
+
+		expected := "SELECT users.id FROM users WHERE users.active = ? " +
+			"UNION SELECT admins.user_id FROM admins " +
+			"INTERSECT SELECT admins.user_id FROM admins"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+	})
+
+	t.Run("should reject parenthesized operands for sqlite", func(t *testing.T) {
+		stmt := dql.Union(users(true), dql.Union(admins(), users(false)))
+
+		_, _, err := CompileWith(stmt, dialect.SQLite())
+
+		assert.EqualError(t, err, "UNION operand cannot be parenthesized for the sqlite dialect")
+	})
+
+	t.Run("should require ordering for sqlserver limits", func(t *testing.T) {
+		stmt := dql.Union(users(true), admins()).Limit(10)
+
+		_, _, err := CompileWith(stmt, dialect.SQLServer())
+
+		assert.EqualError(t, err, "LIMIT on a set operation requires ORDER BY for the sqlserver dialect")
+
+		stmt = dql.Union(users(true), admins()).OrderBy(sst.NewColumnRef("", "id")).Limit(10)
+
+		sql, args, err := CompileWith(stmt, dialect.SQLServer(whenNeeded))
+
+		expected := "SELECT users.id FROM users WHERE users.active = @p1 " +
+			"UNION SELECT admins.user_id FROM admins " +
+			"ORDER BY id OFFSET 0 ROWS FETCH NEXT @p2 ROWS ONLY"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{true, 10}, args)
+	})
+
+	t.Run("should record operand errors", func(t *testing.T) {
+		stmt := dql.Union(users(true), dql.Select().Where(nil))
+
+		_, _, err := Compile(stmt)
+
+		assert.EqualError(t, err, "WHERE condition cannot be nil")
+
+		_, _, err = Compile(dql.Union(users(true), nil))
+
+		assert.EqualError(t, err, "set operation operand cannot be nil")
+	})
+}
+
+func TestCompileSelectWithCommonTableExpressions(t *testing.T) {
+	whenNeeded := dialect.WithQuotePolicy(dialect.QuoteWhenNeeded)
+
+	t.Run("should render a recursive tree query", func(t *testing.T) {
+		employees := sst.NewTableRef("employees")
+		reports := sst.NewTableRef("employees", sst.WithTableAlias("e"))
+		self := sst.NewTableRef("tree")
+		anchor := dql.Select(employees.Column("id"), employees.Column("manager_id"))
+		anchor.From(employees).Where(sst.Eq(employees.Column("id"), sst.NewBindParam(1)))
+		step := dql.Select(reports.Column("id"), reports.Column("manager_id"))
+		step.From(reports).Join(self).On(sst.Eq(reports.Column("manager_id"), self.Column("id")))
+		tree := sst.CTE("tree", dql.UnionAll(anchor, step),
+			sst.WithCTEColumns("id", "manager_id"),
+			sst.WithRecursive(),
+		)
+		stmt := dql.Select(tree.Column("id")).With(tree).From(tree)
+
+		expected := "WITH RECURSIVE tree (id, manager_id) AS (" +
+			"SELECT employees.id, employees.manager_id FROM employees WHERE employees.id = $1 " +
+			"UNION ALL SELECT e.id, e.manager_id FROM employees AS e JOIN tree ON e.manager_id = tree.id) " +
+			"SELECT tree.id FROM tree"
+
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(whenNeeded))
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{1}, args)
+
+		sql, _, err = CompileWith(stmt, dialect.SQLServer(whenNeeded))
+
+		expected = "WITH tree (id, manager_id) AS (" +
+			"SELECT employees.id, employees.manager_id FROM employees WHERE employees.id = @p1 " +
+			"UNION ALL SELECT e.id, e.manager_id FROM employees AS e JOIN tree ON e.manager_id = tree.id) " +
+			"SELECT tree.id FROM tree"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+	})
+
+	t.Run("should render several queries with hints and aliased references", func(t *testing.T) {
+		active := dql.Select(sst.NewColumnRef("users", "id"))
+		active.From(sst.NewTableRef("users")).
+			Where(sst.Eq(sst.NewColumnRef("users", "active"), sst.NewBindParam(true)))
+		totals := dql.Select(sst.NewColumnRef("orders", "user_id"), sst.Sum(sst.NewColumnRef("orders", "total")))
+		totals.From(sst.NewTableRef("orders")).GroupBy(sst.NewColumnRef("orders", "user_id"))
+		activeCTE := sst.CTE("active", active, sst.WithMaterialized())
+		totalsCTE := sst.CTE("totals", totals, sst.WithCTEColumns("user_id", "amount"), sst.WithNotMaterialized())
+		a, t2 := activeCTE.Ref(sst.WithTableAlias("a")), totalsCTE.Ref(sst.WithTableAlias("t"))
+		stmt := dql.Select(a.Column("id"), t2.Column("amount")).
+			With(activeCTE).
+			With(totalsCTE).
+			From(a).
+			LeftJoin(t2).
+			On(sst.Eq(a.Column("id"), t2.Column("user_id"))).
+			Where(sst.Gt(t2.Column("amount"), sst.NewBindParam(100)))
+
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(whenNeeded))
+
+		expected := "WITH active AS MATERIALIZED (SELECT users.id FROM users WHERE users.active = $1), " +
+			"totals (user_id, amount) AS NOT MATERIALIZED (" +
+			"SELECT orders.user_id, SUM(orders.total) FROM orders GROUP BY orders.user_id) " +
+			"SELECT a.id, t.amount FROM active AS a LEFT JOIN totals AS t ON a.id = t.user_id " +
+			"WHERE t.amount > $2"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{true, 100}, args)
+
+		_, _, err = CompileWith(stmt, dialect.MySQL())
+
+		assert.EqualError(t, err, "MATERIALIZED hints are not supported by the mysql dialect")
+	})
+
+	t.Run("should declare queries ahead of a compound statement", func(t *testing.T) {
+		ids := sst.CTE("ids", dql.Select(sst.NewLiteral(1)))
+		stmt := dql.Union(
+			dql.Select(ids.Column("x")).From(ids),
+			dql.Select(sst.NewLiteral(2)),
+		).With(ids)
+
+		sql, _, err := Compile(stmt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "WITH ids AS (SELECT 1) SELECT ids.x FROM ids UNION SELECT 2", sql)
+	})
+
+	t.Run("should reject duplicate names", func(t *testing.T) {
+		first := sst.CTE("ids", dql.Select(sst.NewLiteral(1)))
+		second := sst.CTE("ids", dql.Select(sst.NewLiteral(2)))
+		stmt := dql.Select(sst.NewLiteral(1)).With(first, second)
+
+		_, _, err := Compile(stmt)
+
+		assert.EqualError(t, err, `duplicate common table expression "ids"`)
+	})
+
+	t.Run("should record invalid names", func(t *testing.T) {
+		stmt := dql.Select(sst.NewLiteral(1)).With(sst.CTE("", dql.Select(sst.NewLiteral(1))))
+
+		assert.EqualError(t, stmt.Err(), "invalid common table expression name: identifier cannot be empty")
+	})
+}
+
+func TestCompileSelectWithDerivedTables(t *testing.T) {
+	whenNeeded := dialect.WithQuotePolicy(dialect.QuoteWhenNeeded)
+
+	t.Run("should render derived sources in FROM and JOIN", func(t *testing.T) {
+		totals := dql.Select(sst.NewColumnRef("orders", "user_id"), sst.Sum(sst.NewColumnRef("orders", "total")))
+		totals.From(sst.NewTableRef("orders")).
+			Where(sst.Gt(sst.NewColumnRef("orders", "total"), sst.NewBindParam(0))).
+			GroupBy(sst.NewColumnRef("orders", "user_id"))
+		t2 := sst.Derived(totals, "t", sst.WithDerivedColumns("user_id", "amount"))
+		active := dql.Select(sst.NewColumnRef("users", "id"))
+		active.From(sst.NewTableRef("users")).
+			Where(sst.Eq(sst.NewColumnRef("users", "active"), sst.NewBindParam(true)))
+		u := sst.Derived(active, "u")
+		stmt := dql.Select(u.Column("id"), t2.Column("amount")).
+			From(u).
+			Join(t2).
+			On(sst.Eq(u.Column("id"), t2.Column("user_id"))).
+			Where(sst.Gt(t2.Column("amount"), sst.NewBindParam(100)))
+
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(whenNeeded))
+
+		expected := "SELECT u.id, t.amount " +
+			"FROM (SELECT users.id FROM users WHERE users.active = $1) AS u " +
+			"JOIN (SELECT orders.user_id, SUM(orders.total) FROM orders WHERE orders.total > $2 " +
+			"GROUP BY orders.user_id) AS t (user_id, amount) ON u.id = t.user_id " +
+			"WHERE t.amount > $3"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{true, 0, 100}, args)
+	})
+
+	t.Run("should render lateral joins where supported", func(t *testing.T) {
+		users := sst.NewTableRef("users")
+		latest := dql.Select(sst.NewColumnRef("orders", "total"))
+		latest.From(sst.NewTableRef("orders")).
+			Where(sst.Eq(sst.NewColumnRef("orders", "user_id"), users.Column("id"))).
+			OrderBy(sst.Desc(sst.NewColumnRef("orders", "created_at"))).
+			Limit(1)
+		last := sst.Lateral(latest, "last")
+		stmt := dql.Select(users.Column("id"), last.Column("total")).
+			From(users).
+			LeftJoin(last).
+			On(sst.NewLiteral("TRUE"))
+
+		sql, args, err := CompileWith(stmt, dialect.MySQL(whenNeeded))
+
+		expected := "SELECT users.id, last.total FROM users LEFT JOIN LATERAL (" +
+			"SELECT orders.total FROM orders WHERE orders.user_id = users.id " +
+			"ORDER BY orders.created_at DESC LIMIT ?) AS last ON TRUE"
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, sql)
+		assert.Equal(t, []any{1}, args)
+
+		_, _, err = CompileWith(stmt, dialect.SQLite())
+
+		assert.EqualError(t, err, "LATERAL is not supported by the sqlite dialect")
+	})
+
+	t.Run("should record a missing alias", func(t *testing.T) {
+		stmt := dql.Select(sst.NewLiteral(1)).From(sst.Derived(dql.Select(sst.NewLiteral(1)), ""))
+
+		assert.EqualError(t, stmt.Err(), "invalid derived table alias: identifier cannot be empty")
+	})
+
+	t.Run("should reject a missing query", func(t *testing.T) {
+		stmt := dql.Select(sst.NewLiteral(1)).From(sst.Derived(nil, "d"))
+
+		_, _, err := Compile(stmt)
+
+		assert.EqualError(t, err, `derived table "d" requires a query`)
+	})
+}
+
+func TestCompileSelectWithScalarSubqueries(t *testing.T) {
+	u := sst.NewTableRef("users", sst.WithTableAlias("u"))
+	o := sst.NewTableRef("orders", sst.WithTableAlias("o"))
+	count := dql.Select(sst.CountAll())
+	count.From(o).Where(sst.And(
+		sst.Eq(o.Column("user_id"), u.Column("id")),
+		sst.Gt(o.Column("total"), sst.NewBindParam(10)),
+	))
+	average := dql.Select(sst.Avg(sst.NewColumnRef("products", "price")))
+	average.From(sst.NewTableRef("products")).
+		Where(sst.Eq(sst.NewColumnRef("products", "category"), sst.NewBindParam("books")))
+	stmt := dql.Select(
+		u.Column("id"),
+		sst.As(sst.Subquery(count), "orders"),
+	).From(u).Where(sst.And(
+		sst.Eq(u.Column("active"), sst.NewBindParam(true)),
+		sst.Gt(u.Column("budget"), sst.Subquery(average)),
+		sst.Eq(sst.Subquery(count), sst.Add(sst.NewBindParam(1), sst.Subquery(count))),
+	))
+
+	sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+		dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+	))
+
+	countSQL := func(n int) string {
+		return fmt.Sprintf("(SELECT COUNT(*) FROM orders AS o WHERE o.user_id = u.id AND o.total > $%d)", n)
+	}
+	expected := "SELECT u.id, " + countSQL(1) + " AS orders FROM users AS u " +
+		"WHERE u.active = $2 " +
+		"AND u.budget > (SELECT AVG(products.price) FROM products WHERE products.category = $3) " +
+		"AND " + countSQL(4) + " = $5 + " + countSQL(6)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, sql)
+	assert.Equal(t, []any{10, true, "books", 10, 1, 10}, args)
+
+	t.Run("should reject a missing query", func(t *testing.T) {
+		_, _, err := Compile(dql.Select(sst.Subquery(nil)))
+
+		assert.EqualError(t, err, "subquery cannot be nil")
+	})
+
+	t.Run("should report nested construction errors", func(t *testing.T) {
+		inner := dql.Select(sst.NewColumnRef("users", "id")).Limit(-1)
+
+		_, _, err := Compile(dql.Select(sst.Subquery(inner)))
+
+		assert.EqualError(t, err, inner.Err().Error())
+	})
+}
+
+func TestCompileSelectDistinct(t *testing.T) {
+	t.Run("should render DISTINCT before TOP", func(t *testing.T) {
+		stmt := dql.Select(sst.NewColumnRef("users", "name")).
+			Distinct().
+			From(sst.NewTableRef("users")).
+			Limit(5)
+
+		sql, args, err := CompileWith(stmt, dialect.SQLServer())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT DISTINCT TOP (@p1) [users].[name] FROM [users]", sql)
+		assert.Equal(t, []any{5}, args)
+	})
+
+	t.Run("should render DISTINCT ON leading the ORDER BY terms", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.NewColumnRef("users", "team_id"),
+			sst.NewColumnRef("users", "name"),
+		).
+			DistinctOn(sst.NewColumnRef("users", "team_id"), sst.Lower(sst.NewColumnRef("users", "role"))).
+			From(sst.NewTableRef("users")).
+			OrderBy(
+				sst.Lower(sst.NewColumnRef("users", "role")),
+				sst.Asc(sst.NewColumnRef("users", "team_id")),
+				sst.Desc(sst.NewColumnRef("users", "created_at")),
+			)
+
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT DISTINCT ON (users.team_id, LOWER(users.role)) users.team_id, users.name "+
+			"FROM users ORDER BY LOWER(users.role), users.team_id ASC, users.created_at DESC", sql)
+		assert.Empty(t, args)
+	})
+
+	t.Run("should reject DISTINCT ON not leading the ORDER BY terms", func(t *testing.T) {
+		stmt := dql.Select(sst.NewColumnRef("users", "name")).
+			DistinctOn(sst.NewColumnRef("users", "team_id")).
+			From(sst.NewTableRef("users")).
+			OrderBy(sst.NewColumnRef("users", "name"), sst.NewColumnRef("users", "team_id"))
+
+		_, _, err := CompileWith(stmt, dialect.PostgreSQL())
+
+		assert.EqualError(t, err, "DISTINCT ON expressions must match the leading ORDER BY terms")
+	})
+
+	t.Run("should reject DISTINCT ON without dialect support", func(t *testing.T) {
+		stmt := dql.Select(sst.NewColumnRef("users", "name")).
+			DistinctOn(sst.NewColumnRef("users", "team_id")).
+			From(sst.NewTableRef("users"))
+
+		_, _, err := CompileWith(stmt, dialect.MySQL())
+
+		assert.EqualError(t, err, "DISTINCT ON is not supported by the mysql dialect")
+	})
+}
@@ -0,0 +1,520 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/candango/sqlok/internal/dialect"
+	"github.com/candango/sqlok/internal/sst"
+)
+
+// Severity classifies a diagnostic finding.
+type Severity uint8
+
+const (
+	// SeverityWarning marks a statement the database accepts but that is
+	// likely wrong, such as an accidental cartesian product.
+	SeverityWarning Severity = iota
+	// SeverityError marks a statement the database rejects.
+	SeverityError
+)
+
+// String returns the lowercase severity name.
+func (s Severity) String() string {
+	if s == SeverityError {
+		return "error"
+	}
+	return "warning"
+}
+
+// DiagnosticCode identifies the kind of a diagnostic finding.
+type DiagnosticCode string
+
+const (
+	// MissingJoinCondition reports a join without ON or USING that is not an
+	// explicit CROSS or NATURAL join.
+	MissingJoinCondition DiagnosticCode = "missing-join-condition"
+	// DisconnectedSource reports a FROM source no join condition or WHERE
+	// predicate links to the primary source.
+	DisconnectedSource DiagnosticCode = "disconnected-source"
+	// UnknownTable reports a column qualified by a table missing from FROM.
+	UnknownTable DiagnosticCode = "unknown-table"
+)
+
+// Diagnostic is one finding of Diagnose.
+type Diagnostic struct {
+	Severity Severity
+	Code     DiagnosticCode
+	// Table is the source or qualifier the finding is about.
+	Table   string
+	Message string
+}
+
+// String returns the severity followed by the message.
+func (d Diagnostic) String() string {
+	return d.Severity.String() + ": " + d.Message
+}
+
+// Diagnose checks the FROM sources of stmt and of every nested query scope
+// for accidental cartesian products: joins without conditions, sources not
+// linked to the primary source by any join condition or WHERE conjunct, and
+// columns qualified by tables missing from FROM. UPDATE ... FROM and
+// DELETE ... USING sources are checked against the target table the same
+// way. A join without a condition is an error unless the dialect evaluates it
+// as a cross join, in which case it is a warning. A disconnected source is
+// valid SQL in every dialect, so it is always a warning. Explicit CROSS and
+// NATURAL joins and USING conditions are intentional and never reported.
+//
+// Diagnose is independent from compilation: statements with findings still
+// compile, and construction errors are left to the compiler.
+func Diagnose(stmt sst.StatementNode, d dialect.Dialect) []Diagnostic {
+	return diagnose(stmt, d, nil)
+}
+
+// diagnose reports the findings of stmt followed by those of its nested
+// scopes. Outer lists the sources visible to a LATERAL query or to a
+// subquery, which may be correlated.
+func diagnose(stmt sst.StatementNode, d dialect.Dialect, outer []string) []Diagnostic {
+	var diagnostics []Diagnostic
+	switch node := stmt.(type) {
+	case sst.SelectStatementNode:
+		l := newSourceLinter(outer)
+		// Traversal errors belong to compilation; partial findings are still
+		// reported.
+		_ = node.Accept(l)
+		diagnostics = l.diagnostics(d)
+		if with := node.WithClause(); with != nil {
+			diagnostics = append(diagnostics, diagnoseWith(with, d)...)
+		}
+		diagnostics = append(diagnostics, l.nestedDiagnostics(d)...)
+	case sst.UpdateStatementNode:
+		var exprs []sst.Node
+		for _, assignment := range node.Assignments() {
+			exprs = append(exprs, assignment.Value)
+		}
+		if returning := node.ReturningClause(); returning != nil {
+			exprs = append(exprs, returning.Expressions())
+		}
+		diagnostics = diagnoseDML(node.Table(), node.Sources(), node.Condition(), exprs, d, outer)
+	case sst.DeleteStatementNode:
+		var exprs []sst.Node
+		if returning := node.ReturningClause(); returning != nil {
+			exprs = append(exprs, returning.Expressions())
+		}
+		diagnostics = diagnoseDML(node.Table(), node.Sources(), node.Condition(), exprs, d, outer)
+	case sst.SetOperationNode:
+		if with := node.WithClause(); with != nil {
+			diagnostics = append(diagnostics, diagnoseWith(with, d)...)
+		}
+		diagnostics = append(diagnostics, diagnose(node.Left(), d, outer)...)
+		diagnostics = append(diagnostics, diagnose(node.Right(), d, outer)...)
+	case sst.InsertStatementNode:
+		if query := node.Query(); query != nil {
+			diagnostics = append(diagnostics, diagnose(query, d, outer)...)
+		}
+	}
+	return diagnostics
+}
+
+// diagnoseDML reports the findings of an UPDATE or DELETE scope, whose
+// target is the primary source and whose additional sources are linked only
+// through WHERE conjuncts. Exprs are the assignment values and RETURNING
+// expressions, which may reference the sources.
+func diagnoseDML(
+	table sst.TableRefNode,
+	sources []sst.TableRefNode,
+	condition sst.ExpressionNode,
+	exprs []sst.Node,
+	d dialect.Dialect,
+	outer []string,
+) []Diagnostic {
+	l := newSourceLinter(outer)
+	l.started = true
+	l.addSource(table, nil)
+	for _, source := range sources {
+		l.addSource(source, nil)
+	}
+	// Traversal errors belong to compilation; partial findings are still
+	// reported.
+	for _, expr := range exprs {
+		if expr != nil {
+			_ = expr.Accept(l)
+		}
+	}
+	if condition != nil {
+		l.clause = "WHERE"
+		l.whereDepth = l.depth
+		l.group = l.newGroup()
+		_ = condition.Accept(l)
+	}
+	return append(l.diagnostics(d), l.nestedDiagnostics(d)...)
+}
+
+func diagnoseWith(with sst.WithClauseNode, d dialect.Dialect) []Diagnostic {
+	var diagnostics []Diagnostic
+	for _, cte := range with.Tables() {
+		if cte == nil {
+			continue
+		}
+		diagnostics = append(diagnostics, diagnose(cte.Query(), d, nil)...)
+	}
+	return diagnostics
+}
+
+// lintSource is one FROM source of the linted scope.
+type lintSource struct {
+	name string
+	join sst.JoinNode
+}
+
+// lintRef is a qualified column reference and the predicate group it was
+// found in, or -1 outside join conditions and WHERE conjuncts.
+type lintRef struct {
+	table  string
+	column string
+	group  int
+}
+
+// sourceLinter walks one SELECT scope, recording its FROM sources and the
+// qualified column references of every clause. Nested statements belong to
+// their own scope, so references inside subqueries are skipped and the
+// subqueries are recorded to be diagnosed on their own.
+type sourceLinter struct {
+	outer      []string
+	sources    []lintSource
+	names      []string
+	derived    []sst.DerivedTableNode
+	subqueries []sst.StatementNode
+	refs       []lintRef
+	started    bool
+	depth      int
+	nestedAt   int
+	clause     string
+	whereDepth int
+	group      int
+	groups     int
+}
+
+var _ sst.Visitor = (*sourceLinter)(nil)
+
+func newSourceLinter(outer []string) *sourceLinter {
+	return &sourceLinter{
+		outer:    outer,
+		nestedAt: -1,
+		group:    -1,
+	}
+}
+
+// nestedDiagnostics reports the findings of the derived tables and
+// subqueries recorded while walking the scope.
+func (l *sourceLinter) nestedDiagnostics(d dialect.Dialect) []Diagnostic {
+	var diagnostics []Diagnostic
+	for _, derived := range l.derived {
+		var visible []string
+		if derived.Lateral() {
+			visible = l.names
+		}
+		diagnostics = append(diagnostics, diagnose(derived.Query(), d, visible)...)
+	}
+	visible := append(append([]string(nil), l.outer...), l.names...)
+	for _, subquery := range l.subqueries {
+		diagnostics = append(diagnostics, diagnose(subquery, d, visible)...)
+	}
+	return diagnostics
+}
+
+func (l *sourceLinter) nested() bool {
+	return l.nestedAt >= 0
+}
+
+func (l *sourceLinter) newGroup() int {
+	l.groups++
+	return l.groups - 1
+}
+
+func (l *sourceLinter) addSource(table sst.TableRefNode, join sst.JoinNode) {
+	if table == nil {
+		return
+	}
+	name := table.Alias()
+	if name == "" {
+		name = table.Name()
+	}
+	l.sources = append(l.sources, lintSource{name: name, join: join})
+	l.names = append(l.names, name)
+	if derived, ok := table.(sst.DerivedTableNode); ok {
+		l.derived = append(l.derived, derived)
+	}
+}
+
+func (l *sourceLinter) VisitStatement(stmt sst.StatementNode) error {
+	if !l.started {
+		l.started = true
+	} else if !l.nested() {
+		l.nestedAt = l.depth
+		l.subqueries = append(l.subqueries, stmt)
+	}
+	return nil
+}
+
+func (l *sourceLinter) VisitClause(clause sst.ClauseNode) error {
+	if l.nested() {
+		return nil
+	}
+	l.clause = clause.Declaration()
+	l.group = -1
+	if l.clause == "WHERE" {
+		l.whereDepth = l.depth
+		l.group = l.newGroup()
+	}
+	return nil
+}
+
+func (l *sourceLinter) VisitFromSource(source sst.FromSourceNode) error {
+	if l.nested() {
+		return nil
+	}
+	l.addSource(source.Table(), nil)
+	if join := source.Join(); join != nil {
+		return join.Accept(l)
+	}
+	return nil
+}
+
+func (l *sourceLinter) VisitJoin(j sst.JoinNode) error {
+	right := j.Right()
+	if right == nil {
+		return nil
+	}
+	l.addSource(right.Table(), j)
+	if on := j.On(); on != nil {
+		l.group = l.newGroup()
+		if err := on.Accept(l); err != nil {
+			return err
+		}
+		l.group = -1
+	}
+	if next := right.Join(); next != nil {
+		return next.Accept(l)
+	}
+	return nil
+}
+
+func (l *sourceLinter) VisitColumnRef(column sst.ColumnRefNode) error {
+	if l.nested() || column.Table() == "" {
+		return nil
+	}
+	l.refs = append(l.refs, lintRef{
+		table:  column.Table(),
+		column: column.Name(),
+		group:  l.group,
+	})
+	return nil
+}
+
+// VisitExpression starts a new predicate group at every top-level AND of the
+// WHERE clause, so unrelated conjuncts do not link their sources.
+func (l *sourceLinter) VisitExpression(expr sst.ExpressionNode) error {
+	if l.nested() || l.clause != "WHERE" || l.depth != l.whereDepth {
+		return nil
+	}
+	if e, ok := expr.(sst.LogicalExpressionNode); ok && e.Operator() == sst.AndOperator {
+		l.group = l.newGroup()
+	}
+	return nil
+}
+
+func (l *sourceLinter) VisitExpressionGroupStart() error {
+	l.depth++
+	return nil
+}
+
+func (l *sourceLinter) VisitExpressionGroupEnd() error {
+	l.depth--
+	if l.nested() && l.depth < l.nestedAt {
+		l.nestedAt = -1
+	}
+	return nil
+}
+
+func (l *sourceLinter) VisitCast(e sst.CastExpressionNode) error {
+	return e.Operand().Accept(l)
+}
+
+func (l *sourceLinter) VisitConcat(e sst.ConcatExpressionNode) error {
+	for _, operand := range e.Operands() {
+		if err := operand.Accept(l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *sourceLinter) VisitDistinct(d sst.DistinctClauseNode) error {
+	if on := d.On(); on != nil {
+		return on.Accept(l)
+	}
+	return nil
+}
+
+func (l *sourceLinter) VisitDistinctFrom(e sst.DistinctFromExpressionNode) error {
+	if err := e.Left().Accept(l); err != nil {
+		return err
+	}
+	return e.Right().Accept(l)
+}
+
+func (l *sourceLinter) VisitPattern(e sst.PatternExpressionNode) error {
+	if err := e.Subject().Accept(l); err != nil {
+		return err
+	}
+	return e.Pattern().Accept(l)
+}
+
+func (l *sourceLinter) VisitOrdering(o sst.OrderingNode) error {
+	return o.Expression().Accept(l)
+}
+
+func (l *sourceLinter) VisitTableRef(sst.TableRefNode) error {
+	return nil
+}
+
+func (l *sourceLinter) VisitDerivedTable(sst.DerivedTableNode) error {
+	return nil
+}
+
+func (l *sourceLinter) VisitInsert(sst.InsertStatementNode) error {
+	return nil
+}
+
+func (l *sourceLinter) VisitUpdate(sst.UpdateStatementNode) error {
+	return nil
+}
+
+func (l *sourceLinter) VisitDelete(sst.DeleteStatementNode) error {
+	return nil
+}
+
+// VisitSetOperation records a set operation subquery. Set operations do not
+// traverse their operands here, so they never enter the nested state.
+func (l *sourceLinter) VisitSetOperation(s sst.SetOperationNode) error {
+	if l.started && !l.nested() {
+		l.subqueries = append(l.subqueries, s)
+	}
+	return nil
+}
+
+func (l *sourceLinter) VisitWith(sst.WithClauseNode) error {
+	return nil
+}
+
+func (l *sourceLinter) VisitLimit(sst.LimitClauseNode, sst.LimitPosition) error {
+	return nil
+}
+
+func (l *sourceLinter) VisitListSeparator(int) error {
+	return nil
+}
+
+// diagnostics resolves the recorded references against the FROM sources and
+// reports the findings of the scope in source order, followed by unknown
+// tables in reference order.
+func (l *sourceLinter) diagnostics(d dialect.Dialect) []Diagnostic {
+	index := make(map[string]int, len(l.sources))
+	for i, source := range l.sources {
+		if _, ok := index[source.name]; !ok {
+			index[source.name] = i
+		}
+	}
+	visible := make(map[string]struct{}, len(l.outer))
+	for _, name := range l.outer {
+		visible[name] = struct{}{}
+	}
+
+	parent := make([]int, len(l.sources))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	union := func(a, b int) {
+		parent[find(a)] = find(b)
+	}
+
+	var diagnostics []Diagnostic
+	reported := make(map[int]bool, len(l.sources))
+	for i, source := range l.sources {
+		j := source.join
+		if j == nil {
+			continue
+		}
+		switch {
+		case j.Type() == sst.CrossJoin, j.Type() == sst.NaturalJoin, len(j.Using()) > 0:
+			union(i, i-1)
+		case j.On() == nil:
+			severity := SeverityError
+			if (j.Type() == sst.Join || j.Type() == sst.InnerJoin) &&
+				d.Supports(dialect.ConditionlessJoin) {
+				severity = SeverityWarning
+			}
+			diagnostics = append(diagnostics, Diagnostic{
+				Severity: severity,
+				Code:     MissingJoinCondition,
+				Table:    source.name,
+				Message:  fmt.Sprintf("%s %q has no ON or USING condition", j.Type(), source.name),
+			})
+			reported[i] = true
+		}
+	}
+
+	linked := make(map[int]int)
+	unknown := make(map[string]bool)
+	var missing []Diagnostic
+	for _, ref := range l.refs {
+		i, ok := index[ref.table]
+		if !ok {
+			if _, ok := visible[ref.table]; ok || unknown[ref.table] {
+				continue
+			}
+			unknown[ref.table] = true
+			missing = append(missing, Diagnostic{
+				Severity: SeverityError,
+				Code:     UnknownTable,
+				Table:    ref.table,
+				Message: fmt.Sprintf(
+					"column %q references table %q, which is missing from FROM",
+					ref.column, ref.table,
+				),
+			})
+			continue
+		}
+		if ref.group < 0 {
+			continue
+		}
+		if first, ok := linked[ref.group]; ok {
+			union(i, first)
+		} else {
+			linked[ref.group] = i
+		}
+	}
+
+	for i, source := range l.sources {
+		if i == 0 || reported[i] || find(i) == find(0) {
+			continue
+		}
+		diagnostics = append(diagnostics, Diagnostic{
+			Severity: SeverityWarning,
+			Code:     DisconnectedSource,
+			Table:    source.name,
+			Message: fmt.Sprintf(
+				"source %q is not linked to %q and produces a cartesian product",
+				source.name, l.sources[0].name,
+			),
+		})
+	}
+	return append(diagnostics, missing...)
+}
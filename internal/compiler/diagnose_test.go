@@ -0,0 +1,325 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/candango/sqlok/internal/dialect"
+	"github.com/candango/sqlok/internal/sst"
+	"github.com/candango/sqlok/internal/sst/dml"
+	"github.com/candango/sqlok/internal/sst/dql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnoseLinkedStatement(t *testing.T) {
+	diagnostics := Diagnose(dialectGoldenStatement(), dialect.PostgreSQL())
+
+	assert.Empty(t, diagnostics)
+}
+
+func TestDiagnoseJoinsWithoutConditions(t *testing.T) {
+	users := sst.NewTableRef("users")
+	orders := sst.NewTableRef("orders")
+	stmt := dql.Select(users.Column("id")).
+		From(users).
+		Join(orders)
+
+	t.Run("should be an error where the dialect rejects the join", func(t *testing.T) {
+		diagnostics := Diagnose(stmt, dialect.PostgreSQL())
+
+		assert.Equal(t, []Diagnostic{{
+			Severity: SeverityError,
+			Code:     MissingJoinCondition,
+			Table:    "orders",
+			Message:  `JOIN "orders" has no ON or USING condition`,
+		}}, diagnostics)
+	})
+
+	t.Run("should be a warning where the dialect cross joins", func(t *testing.T) {
+		diagnostics := Diagnose(stmt, dialect.MySQL())
+
+		assert.Len(t, diagnostics, 1)
+		assert.Equal(t, SeverityWarning, diagnostics[0].Severity)
+		assert.Equal(t, `warning: JOIN "orders" has no ON or USING condition`, diagnostics[0].String())
+	})
+
+	t.Run("should be an error for outer joins", func(t *testing.T) {
+		stmt := dql.Select(users.Column("id")).
+			From(users).
+			LeftJoin(orders).
+			Where(sst.Eq(users.Column("id"), orders.Column("user_id")))
+
+		diagnostics := Diagnose(stmt, dialect.MySQL())
+
+		assert.Len(t, diagnostics, 1)
+		assert.Equal(t, SeverityError, diagnostics[0].Severity)
+		assert.Equal(t, MissingJoinCondition, diagnostics[0].Code)
+	})
+
+	t.Run("should accept explicit cartesian and shared-column joins", func(t *testing.T) {
+		stmt := dql.Select(users.Column("id")).
+			From(users).
+			CrossJoin(sst.NewTableRef("regions")).
+			NaturalJoin(sst.NewTableRef("profiles")).
+			Join(orders).
+			Using("user_id")
+
+		assert.Empty(t, Diagnose(stmt, dialect.PostgreSQL()))
+	})
+}
+
+func TestDiagnoseDisconnectedSources(t *testing.T) {
+	u := sst.NewTableRef("users", sst.WithTableAlias("u"))
+	o := sst.NewTableRef("orders", sst.WithTableAlias("o"))
+	i := sst.NewTableRef("items", sst.WithTableAlias("i"))
+
+	t.Run("should report sources only linked to themselves", func(t *testing.T) {
+		stmt := dql.Select(u.Column("id")).
+			From(u).
+			Join(o).
+			On(sst.Eq(o.Column("status"), sst.NewBindParam("paid")))
+
+		diagnostics := Diagnose(stmt, dialect.PostgreSQL())
+
+		assert.Equal(t, []Diagnostic{{
+			Severity: SeverityWarning,
+			Code:     DisconnectedSource,
+			Table:    "o",
+			Message:  `source "o" is not linked to "u" and produces a cartesian product`,
+		}}, diagnostics)
+	})
+
+	t.Run("should not link sources through separate WHERE conjuncts", func(t *testing.T) {
+		stmt := dql.Select(u.Column("id")).
+			From(u).
+			Join(o).
+			On(sst.Eq(u.Column("id"), o.Column("user_id"))).
+			Join(i).
+			On(sst.Gt(i.Column("quantity"), sst.NewBindParam(0))).
+			Where(sst.And(
+				sst.Eq(u.Column("active"), sst.NewBindParam(true)),
+				sst.Eq(i.Column("sku"), sst.NewBindParam("a-1")),
+			))
+
+		diagnostics := Diagnose(stmt, dialect.PostgreSQL())
+
+		assert.Len(t, diagnostics, 1)
+		assert.Equal(t, DisconnectedSource, diagnostics[0].Code)
+		assert.Equal(t, "i", diagnostics[0].Table)
+	})
+
+	t.Run("should link sources through a WHERE conjunct", func(t *testing.T) {
+		stmt := dql.Select(u.Column("id")).
+			From(u).
+			Join(o).
+			On(sst.Eq(u.Column("id"), o.Column("user_id"))).
+			Join(i).
+			On(sst.Gt(i.Column("quantity"), sst.NewBindParam(0))).
+			Where(sst.And(
+				sst.Eq(u.Column("active"), sst.NewBindParam(true)),
+				sst.Or(
+					sst.Eq(i.Column("order_id"), o.Column("id")),
+					sst.IsNullExpr(i.Column("order_id")),
+				),
+			))
+
+		assert.Empty(t, Diagnose(stmt, dialect.PostgreSQL()))
+	})
+}
+
+func TestDiagnoseDMLSources(t *testing.T) {
+	u := sst.NewTableRef("users", sst.WithTableAlias("u"))
+	o := sst.NewTableRef("orders", sst.WithTableAlias("o"))
+	s := sst.NewTableRef("sessions", sst.WithTableAlias("s"))
+
+	t.Run("should report UPDATE FROM sources not linked to the target", func(t *testing.T) {
+		stmt := dml.Update(u).
+			Set("total", o.Column("amount")).
+			From(o).
+			Where(sst.Eq(o.Column("status"), sst.NewBindParam("paid")))
+
+		diagnostics := Diagnose(stmt, dialect.PostgreSQL())
+
+		assert.Equal(t, []Diagnostic{{
+			Severity: SeverityWarning,
+			Code:     DisconnectedSource,
+			Table:    "o",
+			Message:  `source "o" is not linked to "u" and produces a cartesian product`,
+		}}, diagnostics)
+
+		linked := dml.Update(u).
+			Set("total", o.Column("amount")).
+			From(o).
+			Where(sst.And(
+				sst.Eq(o.Column("user_id"), u.Column("id")),
+				sst.Eq(o.Column("status"), sst.NewBindParam("paid")),
+			))
+
+		assert.Empty(t, Diagnose(linked, dialect.PostgreSQL()))
+	})
+
+	t.Run("should report DELETE USING sources and unknown tables", func(t *testing.T) {
+		stmt := dml.Delete(s).
+			Using(u).
+			Where(sst.Eq(u.Column("active"), sst.NewBindParam(false))).
+			Returning(sst.NewColumnRef("payments", "id"))
+
+		diagnostics := Diagnose(stmt, dialect.MySQL())
+
+		assert.Len(t, diagnostics, 2)
+		assert.Equal(t, DisconnectedSource, diagnostics[0].Code)
+		assert.Equal(t, "u", diagnostics[0].Table)
+		assert.Equal(t, UnknownTable, diagnostics[1].Code)
+		assert.Equal(t, "payments", diagnostics[1].Table)
+	})
+
+	t.Run("should report subqueries of DML statements", func(t *testing.T) {
+		stmt := dml.Delete(s).
+			Where(sst.ExistsSubquery(
+				dql.Select(o.Column("id")).
+					From(o).
+					Join(sst.NewTableRef("items")).
+					Where(sst.Eq(o.Column("user_id"), s.Column("user_id"))),
+			))
+
+		diagnostics := Diagnose(stmt, dialect.PostgreSQL())
+
+		assert.Len(t, diagnostics, 1)
+		assert.Equal(t, MissingJoinCondition, diagnostics[0].Code)
+		assert.Equal(t, "items", diagnostics[0].Table)
+	})
+}
+
+func TestDiagnoseUnknownTables(t *testing.T) {
+	users := sst.NewTableRef("users", sst.WithTableAlias("u"))
+	stmt := dql.Select(
+		sst.NewColumnRef("payments", "amount"),
+		sst.NewColumnRef("", "total"),
+	).
+		From(users).
+		Where(sst.Eq(sst.NewColumnRef("users", "id"), sst.NewBindParam(1))).
+		OrderBy(sst.NewColumnRef("payments", "amount"))
+
+	diagnostics := Diagnose(stmt, dialect.PostgreSQL())
+
+	assert.Equal(t, []Diagnostic{
+		{
+			Severity: SeverityError,
+			Code:     UnknownTable,
+			Table:    "payments",
+			Message:  `column "amount" references table "payments", which is missing from FROM`,
+		},
+		{
+			Severity: SeverityError,
+			Code:     UnknownTable,
+			Table:    "users",
+			Message:  `column "id" references table "users", which is missing from FROM`,
+		},
+	}, diagnostics)
+}
+
+func TestDiagnoseNestedScopes(t *testing.T) {
+	u := sst.NewTableRef("users", sst.WithTableAlias("u"))
+	o := sst.NewTableRef("orders", sst.WithTableAlias("o"))
+
+	t.Run("should skip correlated subquery references", func(t *testing.T) {
+		orders := dql.Select(o.Column("id")).
+			From(o).
+			Where(sst.Eq(o.Column("user_id"), u.Column("id")))
+		stmt := dql.Select(u.Column("id")).
+			From(u).
+			Where(sst.ExistsSubquery(orders))
+
+		assert.Empty(t, Diagnose(stmt, dialect.PostgreSQL()))
+	})
+
+	t.Run("should report derived table and set operand scopes", func(t *testing.T) {
+		disconnected := dql.Select(o.Column("id")).
+			From(o).
+			Join(sst.NewTableRef("items")).
+			On(sst.Gt(sst.NewColumnRef("items", "quantity"), sst.NewBindParam(0)))
+		recent := sst.Derived(disconnected, "recent")
+		stmt := dql.Union(
+			dql.Select(recent.Column("id")).From(recent),
+			dql.Select(u.Column("id")).From(u).Join(o),
+		)
+
+		diagnostics := Diagnose(stmt, dialect.PostgreSQL())
+
+		assert.Len(t, diagnostics, 2)
+		assert.Equal(t, DisconnectedSource, diagnostics[0].Code)
+		assert.Equal(t, "items", diagnostics[0].Table)
+		assert.Equal(t, MissingJoinCondition, diagnostics[1].Code)
+		assert.Equal(t, "o", diagnostics[1].Table)
+	})
+
+	t.Run("should report subquery scopes with outer sources visible", func(t *testing.T) {
+		i := sst.NewTableRef("items", sst.WithTableAlias("i"))
+		orders := dql.Select(o.Column("id")).
+			From(o).
+			Join(i).
+			Where(sst.Eq(o.Column("user_id"), u.Column("id")))
+		admins := sst.NewTableRef("admins")
+		roles := dql.Union(
+			dql.Select(admins.Column("user_id")).From(admins),
+			dql.Select(o.Column("user_id")).From(o).Join(i),
+		)
+		stmt := dql.Select(u.Column("id")).
+			From(u).
+			Where(sst.And(
+				sst.ExistsSubquery(orders),
+				sst.Eq(sst.Subquery(dql.Select(sst.NewColumnRef("payments", "total"))), sst.NewBindParam(0)),
+			)).
+			OrderBy(u.Column("id"))
+		union := dql.Select(u.Column("id")).
+			From(u).
+			Where(sst.Eq(sst.Subquery(roles), u.Column("id")))
+
+		diagnostics := Diagnose(stmt, dialect.PostgreSQL())
+
+		assert.Equal(t, []Diagnostic{
+			{
+				Severity: SeverityError,
+				Code:     MissingJoinCondition,
+				Table:    "i",
+				Message:  `JOIN "i" has no ON or USING condition`,
+			},
+			{
+				Severity: SeverityError,
+				Code:     UnknownTable,
+				Table:    "payments",
+				Message:  `column "total" references table "payments", which is missing from FROM`,
+			},
+		}, diagnostics)
+
+		diagnostics = Diagnose(union, dialect.PostgreSQL())
+
+		assert.Len(t, diagnostics, 1)
+		assert.Equal(t, MissingJoinCondition, diagnostics[0].Code)
+		assert.Equal(t, "i", diagnostics[0].Table)
+	})
+
+	t.Run("should report INSERT source query scopes", func(t *testing.T) {
+		stmt := dml.Insert(sst.NewTableRef("archive"), "id").
+			Select(dql.Select(u.Column("id")).From(u).Join(o))
+
+		diagnostics := Diagnose(stmt, dialect.PostgreSQL())
+
+		assert.Len(t, diagnostics, 1)
+		assert.Equal(t, MissingJoinCondition, diagnostics[0].Code)
+		assert.Equal(t, "o", diagnostics[0].Table)
+	})
+
+	t.Run("should let LATERAL queries reference earlier sources", func(t *testing.T) {
+		latest := sst.Lateral(
+			dql.Select(o.Column("total")).
+				From(o).
+				Where(sst.Eq(o.Column("user_id"), u.Column("id"))),
+			"latest",
+		)
+		stmt := dql.Select(u.Column("id"), latest.Column("total")).
+			From(u).
+			CrossJoin(latest)
+
+		assert.Empty(t, Diagnose(stmt, dialect.PostgreSQL()))
+	})
+}
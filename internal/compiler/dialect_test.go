@@ -0,0 +1,271 @@
+package compiler
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/candango/sqlok/internal/dialect"
+	"github.com/candango/sqlok/internal/sst"
+	"github.com/candango/sqlok/internal/sst/dql"
+	"github.com/stretchr/testify/assert"
+)
+
+func dialectGoldenStatement() sst.StatementNode {
+	return dql.Select(
+		sst.NewColumnRef("users", "id", sst.WithColumnSchema("public")),
+		sst.NewColumnRef("orders", "total"),
+	).
+		From(sst.NewTableRef("users", sst.WithTableSchema("public"))).
+		Join(sst.NewTableRef("orders")).
+		On(sst.Eq(
+			sst.NewColumnRef("users", "id"),
+			sst.NewColumnRef("orders", "user_id"),
+		)).
+		Where(sst.And(
+			sst.Eq(sst.NewColumnRef("users", "active"), sst.NewBindParam(true)),
+			sst.Gt(sst.NewColumnRef("orders", "total"), sst.NewBindParam(100)),
+		))
+}
+
+func TestCompileWithDialects(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		expected string
+	}{
+		{
+			name:    "generic",
+			dialect: dialect.Generic(),
+			expected: "SELECT public.users.id, orders.total " +
+				"FROM public.users JOIN orders ON users.id = orders.user_id " +
+				"WHERE users.active = ? AND orders.total > ?",
+		},
+		{
+			name:    "postgresql",
+			dialect: dialect.PostgreSQL(),
+			expected: `SELECT "public"."users"."id", "orders"."total" ` +
+				`FROM "public"."users" JOIN "orders" ON "users"."id" = "orders"."user_id" ` +
+				`WHERE "users"."active" = $1 AND "orders"."total" > $2`,
+		},
+		{
+			name:    "mysql",
+			dialect: dialect.MySQL(),
+			expected: "SELECT `public`.`users`.`id`, `orders`.`total` " +
+				"FROM `public`.`users` JOIN `orders` ON `users`.`id` = `orders`.`user_id` " +
+				"WHERE `users`.`active` = ? AND `orders`.`total` > ?",
+		},
+		{
+			name:    "sqlite",
+			dialect: dialect.SQLite(),
+			expected: `SELECT "public"."users"."id", "orders"."total" ` +
+				`FROM "public"."users" JOIN "orders" ON "users"."id" = "orders"."user_id" ` +
+				`WHERE "users"."active" = ? AND "orders"."total" > ?`,
+		},
+		{
+			name:    "sqlserver",
+			dialect: dialect.SQLServer(),
+			expected: "SELECT [public].[users].[id], [orders].[total] " +
+				"FROM [public].[users] JOIN [orders] ON [users].[id] = [orders].[user_id] " +
+				"WHERE [users].[active] = @p1 AND [orders].[total] > @p2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := CompileWith(dialectGoldenStatement(), tt.dialect)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, sql)
+			assert.Equal(t, []any{true, 100}, args)
+		})
+	}
+}
+
+func TestCompileWithNamedPlaceholders(t *testing.T) {
+	stmt := dql.Select(
+		sst.NewColumnRef("users", "id"),
+	).From(
+		sst.NewTableRef("users"),
+	).Where(sst.And(
+		sst.Eq(sst.NewColumnRef("users", "id"), sst.NewBindParam(42, sst.WithBindName("id"))),
+		sst.Eq(sst.NewColumnRef("users", "active"), sst.NewBindParam(true)),
+	))
+	d := dialect.New("named", dialect.WithPlaceholderStyle(dialect.NamedPlaceholder))
+
+	query, args, err := CompileWith(stmt, d)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT users.id FROM users WHERE users.id = :id AND users.active = :p2", query)
+	assert.Equal(t, []any{sql.Named("id", 42), sql.Named("p2", true)}, args)
+}
+
+func TestCompileRejectsCollidingBindNames(t *testing.T) {
+	a := sst.NewColumnRef("t", "a")
+	b := sst.NewColumnRef("t", "b")
+	d := dialect.New("named", dialect.WithPlaceholderStyle(dialect.NamedPlaceholder))
+
+	t.Run("should reject a name bound to different values", func(t *testing.T) {
+		stmt := dql.Select(a).From(sst.NewTableRef("t")).Where(sst.And(
+			sst.Eq(a, sst.NewBindParam(1)),
+			sst.Eq(b, sst.NewBindParam(2, sst.WithBindName("p1"))),
+		))
+
+		_, _, err := CompileWith(stmt, d)
+
+		assert.EqualError(t, err, `bind name "p1" is used for different values`)
+	})
+
+	t.Run("should bind a repeated name with an equal value once", func(t *testing.T) {
+		stmt := dql.Select(a).From(sst.NewTableRef("t")).Where(sst.And(
+			sst.Eq(a, sst.NewBindParam(1, sst.WithBindName("v"))),
+			sst.Eq(b, sst.NewBindParam(1, sst.WithBindName("v"))),
+			sst.Eq(a, sst.NewBindParam(3)),
+		))
+
+		query, args, err := CompileWith(stmt, d)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT t.a FROM t WHERE t.a = :v AND t.b = :v AND t.a = :p2", query)
+		assert.Equal(t, []any{sql.Named("v", 1), sql.Named("p2", 3)}, args)
+	})
+}
+
+func TestCompileWithNamedSliceParameters(t *testing.T) {
+	stmt := dql.Select(
+		sst.NewColumnRef("users", "id"),
+	).From(
+		sst.NewTableRef("users"),
+	).Where(sst.And(
+		sst.InList(sst.NewColumnRef("users", "id"), sst.NewBindParam([]int{4, 2}, sst.WithBindName("ids"))),
+		sst.InList(sst.NewColumnRef("users", "role"), sst.NewBindParam([]string{"admin"})),
+	))
+	d := dialect.New("named", dialect.WithPlaceholderStyle(dialect.NamedPlaceholder))
+
+	query, args, err := CompileWith(stmt, d)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT users.id FROM users WHERE users.id IN (:ids_1, :ids_2) AND users.role IN (:p3)", query)
+	assert.Equal(t, []any{sql.Named("ids_1", 4), sql.Named("ids_2", 2), sql.Named("p3", "admin")}, args)
+}
+
+func TestCompileKeepsBindNamesOnTypedParameters(t *testing.T) {
+	stmt := dql.Select(sst.NewBindParam(1, sst.WithBindName("id"), sst.WithBindType("bigint")))
+	d := dialect.New("named", dialect.WithPlaceholderStyle(dialect.NamedPlaceholder))
+
+	query, args, err := CompileWith(stmt, d)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT CAST(:id AS bigint)", query)
+	assert.Equal(t, []any{sql.Named("id", 1)}, args)
+}
+
+func TestCompileQuotesUnsafeIdentifiers(t *testing.T) {
+	stmt := dql.Select(
+		sst.NewColumnRef("users", `id" FROM secrets; --`),
+	).From(
+		sst.NewTableRef("user"),
+	).Where(
+		sst.Eq(sst.NewColumnRef("user", "Order"), sst.NewBindParam(1)),
+	)
+
+	t.Run("generic quotes only when needed", func(t *testing.T) {
+		sql, args, err := Compile(stmt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, `SELECT users."id"" FROM secrets; --" FROM "user" WHERE "user"."Order" = ?`, sql)
+		assert.Equal(t, []any{1}, args)
+	})
+
+	t.Run("mysql always quotes and escapes", func(t *testing.T) {
+		sql, _, err := CompileWith(stmt, dialect.MySQL())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SELECT `users`.`id\" FROM secrets; --` FROM `user` WHERE `user`.`Order` = ?", sql)
+	})
+
+	t.Run("postgresql can quote only when needed", func(t *testing.T) {
+		sql, _, err := CompileWith(stmt, dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		assert.NoError(t, err)
+		assert.Equal(t, `SELECT users."id"" FROM secrets; --" FROM "user" WHERE "user"."Order" = $1`, sql)
+	})
+}
+
+func TestCompileRejectsInvalidIdentifiers(t *testing.T) {
+	stmt := dql.Select(
+		sst.NewColumnRef("users", "id"),
+	).From(sst.NewTableRef(""))
+
+	_, _, err := CompileWith(stmt, dialect.PostgreSQL())
+
+	assert.EqualError(t, err, "invalid table name: identifier cannot be empty")
+}
+
+func TestCompileQuotesAliases(t *testing.T) {
+	u1 := sst.NewTableRef("users", sst.WithTableAlias("u1"))
+	u2 := sst.NewTableRef("users", sst.WithTableAlias("Manager"))
+	stmt := dql.Select(
+		u1.Column("name"),
+		sst.As(u2.Column("name"), "manager name"),
+	).
+		From(u1).
+		LeftJoin(u2).
+		On(sst.Eq(u1.Column("manager_id"), u2.Column("id")))
+
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		expected string
+	}{
+		{
+			name:    "generic",
+			dialect: dialect.Generic(),
+			expected: `SELECT u1.name, "Manager".name AS "manager name" ` +
+				`FROM users AS u1 LEFT JOIN users AS "Manager" ON u1.manager_id = "Manager".id`,
+		},
+		{
+			name:    "mysql",
+			dialect: dialect.MySQL(),
+			expected: "SELECT `u1`.`name`, `Manager`.`name` AS `manager name` " +
+				"FROM `users` AS `u1` LEFT JOIN `users` AS `Manager` ON `u1`.`manager_id` = `Manager`.`id`",
+		},
+		{
+			name:    "sqlserver",
+			dialect: dialect.SQLServer(),
+			expected: "SELECT [u1].[name], [Manager].[name] AS [manager name] " +
+				"FROM [users] AS [u1] LEFT JOIN [users] AS [Manager] ON [u1].[manager_id] = [Manager].[id]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := CompileWith(stmt, tt.dialect)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, sql)
+			assert.Empty(t, args)
+		})
+	}
+}
+
+func TestCompileRejectsInvalidAliases(t *testing.T) {
+	t.Run("should reject an empty column alias", func(t *testing.T) {
+		stmt := dql.Select(sst.As(sst.NewColumnRef("users", "id"), ""))
+
+		_, _, err := Compile(stmt)
+
+		assert.EqualError(t, err, "invalid column alias: identifier cannot be empty")
+	})
+
+	t.Run("should reject an invalid table alias", func(t *testing.T) {
+		stmt := dql.Select(
+			sst.NewColumnRef("u", "id"),
+		).From(sst.NewTableRef("users", sst.WithTableAlias("u\x00")))
+
+		_, _, err := Compile(stmt)
+
+		assert.EqualError(t, err, `invalid table alias: identifier "u\x00" contains a NUL byte`)
+	})
+}
@@ -0,0 +1,492 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/candango/sqlok/internal/dialect"
+	"github.com/candango/sqlok/internal/sst"
+	"github.com/candango/sqlok/internal/sst/dml"
+	"github.com/candango/sqlok/internal/sst/dql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompileInsert(t *testing.T) {
+	stmt := dml.Insert(sst.NewTableRef("users", sst.WithTableSchema("app")), "name", "active", "created_at").
+		Values("ana", true, sst.Default()).
+		Values("bia", false, sst.Func("NOW"))
+
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		expected string
+	}{
+		{
+			name:    "generic",
+			dialect: dialect.Generic(),
+			expected: "INSERT INTO app.users (name, active, created_at) " +
+				"VALUES (?, ?, DEFAULT), (?, ?, NOW())",
+		},
+		{
+			name:    "postgresql",
+			dialect: dialect.PostgreSQL(),
+			expected: `INSERT INTO "app"."users" ("name", "active", "created_at") ` +
+				`VALUES ($1, $2, DEFAULT), ($3, $4, NOW())`,
+		},
+		{
+			name:    "sqlserver",
+			dialect: dialect.SQLServer(),
+			expected: "INSERT INTO [app].[users] ([name], [active], [created_at]) " +
+				"VALUES (@p1, @p2, DEFAULT), (@p3, @p4, NOW())",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := CompileWith(stmt, tt.dialect)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, sql)
+			assert.Equal(t, []any{"ana", true, "bia", false}, args)
+		})
+	}
+
+	t.Run("should reject DEFAULT without dialect support", func(t *testing.T) {
+		_, _, err := CompileWith(stmt, dialect.SQLite())
+
+		assert.EqualError(t, err, "DEFAULT values are not supported by the sqlite dialect")
+	})
+}
+
+func TestCompileInsertTargetAlias(t *testing.T) {
+	u := sst.NewTableRef("users", sst.WithTableAlias("u"))
+	stmt := dml.Insert(u, "id").Values(1)
+
+	sql, args, err := CompileWith(stmt, dialect.PostgreSQL())
+
+	assert.NoError(t, err)
+	assert.Equal(t, `INSERT INTO "users" AS "u" ("id") VALUES ($1)`, sql)
+	assert.Equal(t, []any{1}, args)
+
+	for _, d := range []dialect.Dialect{dialect.MySQL(), dialect.SQLServer(), dialect.Generic()} {
+		_, _, err := CompileWith(stmt, d)
+
+		assert.EqualError(t, err, "aliased INSERT targets are not supported by the "+d.Name()+" dialect")
+	}
+}
+
+func TestCompileInsertReturning(t *testing.T) {
+	stmt := dml.Insert(sst.NewTableRef("users")).
+		Values(sst.NewBindParam(1, sst.WithBindType("bigint")), "ana").
+		Returning(sst.NewColumnRef("", "id"), sst.As(sst.Upper(sst.NewColumnRef("", "name")), "label"))
+
+	t.Run("should render RETURNING", func(t *testing.T) {
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "INSERT INTO users VALUES ($1::bigint, $2) RETURNING id, UPPER(name) AS label", sql)
+		assert.Equal(t, []any{1, "ana"}, args)
+	})
+
+	t.Run("should reject RETURNING without dialect support", func(t *testing.T) {
+		_, _, err := CompileWith(stmt, dialect.MySQL())
+
+		assert.EqualError(t, err, "RETURNING is not supported by the mysql dialect")
+	})
+
+	t.Run("should require VALUES rows", func(t *testing.T) {
+		_, _, err := Compile(dml.Insert(sst.NewTableRef("users")))
+
+		assert.EqualError(t, err, "INSERT requires at least one VALUES row or a SELECT query")
+	})
+
+	t.Run("should report construction errors", func(t *testing.T) {
+		_, _, err := Compile(dml.Insert(nil).Values(1))
+
+		assert.EqualError(t, err, "INSERT table cannot be nil")
+	})
+}
+
+func TestCompileInsertSelect(t *testing.T) {
+	users := sst.NewTableRef("users", sst.WithTableAlias("u"))
+	query := dql.Select(users.Column("id"), users.Column("email"), sst.NewBindParam("inactive")).
+		From(users).
+		Where(sst.Eq(users.Column("active"), sst.NewBindParam(false)))
+	stmt := dml.Insert(sst.NewTableRef("archived_users"), "id", "email", "reason").
+		Select(query).
+		OnConflict("id").
+		DoUpdateSet("reason", sst.NewBindParam("rearchived")).
+		Returning(sst.NewColumnRef("", "id"))
+
+	t.Run("should carry the query arguments through", func(t *testing.T) {
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "INSERT INTO archived_users (id, email, reason) "+
+			"SELECT u.id, u.email, $1 FROM users AS u WHERE u.active = $2 "+
+			"ON CONFLICT (id) DO UPDATE SET reason = $3 RETURNING id", sql)
+		assert.Equal(t, []any{"inactive", false, "rearchived"}, args)
+	})
+
+	t.Run("should require a WHERE clause before a SQLite upsert", func(t *testing.T) {
+		sql, args, err := CompileWith(stmt, dialect.SQLite())
+
+		assert.NoError(t, err)
+		assert.Equal(t, `INSERT INTO "archived_users" ("id", "email", "reason") `+
+			`SELECT "u"."id", "u"."email", ? FROM "users" AS "u" WHERE "u"."active" = ? `+
+			`ON CONFLICT ("id") DO UPDATE SET "reason" = ? RETURNING "id"`, sql)
+		assert.Equal(t, []any{"inactive", false, "rearchived"}, args)
+
+		unfiltered := dml.Insert(sst.NewTableRef("archived_users"), "id").
+			Select(dql.Select(users.Column("id")).From(users)).
+			OnConflict("id").
+			DoNothing()
+
+		_, _, err = CompileWith(unfiltered, dialect.SQLite())
+
+		assert.EqualError(t, err, "ON CONFLICT after a SELECT source requires a WHERE clause in the query for the sqlite dialect")
+
+		sql, _, err = CompileWith(unfiltered, dialect.PostgreSQL())
+
+		assert.NoError(t, err)
+		assert.Equal(t, `INSERT INTO "archived_users" ("id") SELECT "u"."id" FROM "users" AS "u" `+
+			`ON CONFLICT ("id") DO NOTHING`, sql)
+	})
+
+	t.Run("should render set operation sources", func(t *testing.T) {
+		admins := sst.NewTableRef("admins")
+		stmt := dml.Insert(sst.NewTableRef("archived_users"), "id").
+			Select(dql.Union(
+				dql.Select(users.Column("id")).From(users),
+				dql.Select(admins.Column("id")).From(admins),
+			))
+
+		sql, args, err := CompileWith(stmt, dialect.MySQL())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "INSERT INTO `archived_users` (`id`) SELECT `u`.`id` FROM `users` AS `u` "+
+			"UNION SELECT `admins`.`id` FROM `admins`", sql)
+		assert.Empty(t, args)
+	})
+}
+
+func TestCompileUpsert(t *testing.T) {
+	users := sst.NewTableRef("users")
+	stmt := dml.Insert(users, "email", "name").
+		Values("ana@example.com", "ana").
+		OnConflict("email").
+		DoUpdateSet("name", sst.Excluded("name")).
+		DoUpdateSet("visits", sst.Add(users.Column("visits"), sst.NewBindParam(1)))
+
+	t.Run("should render DO UPDATE with the excluded row", func(t *testing.T) {
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "INSERT INTO users (email, name) VALUES ($1, $2) "+
+			"ON CONFLICT (email) DO UPDATE SET name = EXCLUDED.name, visits = users.visits + $3", sql)
+		assert.Equal(t, []any{"ana@example.com", "ana", 1}, args)
+
+		sql, _, err = CompileWith(stmt, dialect.SQLite())
+
+		assert.NoError(t, err)
+		assert.Equal(t, `INSERT INTO "users" ("email", "name") VALUES (?, ?) `+
+			`ON CONFLICT ("email") DO UPDATE SET "name" = EXCLUDED."name", `+
+			`"visits" = "users"."visits" + ?`, sql)
+	})
+
+	t.Run("should render ON DUPLICATE KEY UPDATE without a target", func(t *testing.T) {
+		_, _, err := CompileWith(stmt, dialect.MySQL())
+
+		assert.EqualError(t, err, "ON CONFLICT columns are not supported by the mysql dialect "+
+			"without an explicit AnyUniqueKey opt-in")
+
+		sql, args, err := CompileWith(stmt.AnyUniqueKey(), dialect.MySQL())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "INSERT INTO `users` (`email`, `name`) VALUES (?, ?) "+
+			"ON DUPLICATE KEY UPDATE `name` = VALUES(`name`), `visits` = `users`.`visits` + ?", sql)
+		assert.Equal(t, []any{"ana@example.com", "ana", 1}, args)
+
+		sql, _, err = CompileWith(dml.Insert(users, "id").Values(1).OnConflict().DoUpdateSet("id", sst.Excluded("id")), dialect.MySQL())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "INSERT INTO `users` (`id`) VALUES (?) ON DUPLICATE KEY UPDATE `id` = VALUES(`id`)", sql)
+
+		_, _, err = CompileWith(
+			dml.Insert(users, "id").Values(1).OnConflictConstraint("users_pkey").AnyUniqueKey().DoUpdateSet("id", 1),
+			dialect.MySQL(),
+		)
+
+		assert.EqualError(t, err, "ON CONFLICT ON CONSTRAINT is not supported by the mysql dialect")
+	})
+
+	t.Run("should render DO NOTHING and constraint targets", func(t *testing.T) {
+		stmt := dml.Insert(users, "id").
+			Values(1).
+			OnConflictConstraint("users_pkey").
+			DoNothing().
+			Returning(users.Column("id"))
+
+		sql, _, err := CompileWith(stmt, dialect.PostgreSQL())
+
+		assert.NoError(t, err)
+		assert.Equal(t, `INSERT INTO "users" ("id") VALUES ($1) `+
+			`ON CONFLICT ON CONSTRAINT "users_pkey" DO NOTHING RETURNING "users"."id"`, sql)
+
+		_, _, err = CompileWith(stmt, dialect.SQLite())
+
+		assert.EqualError(t, err, "ON CONFLICT ON CONSTRAINT is not supported by the sqlite dialect")
+
+		sql, _, err = CompileWith(dml.Insert(users, "id").Values(1).OnConflict().DoNothing(), dialect.SQLite())
+
+		assert.NoError(t, err)
+		assert.Equal(t, `INSERT INTO "users" ("id") VALUES (?) ON CONFLICT DO NOTHING`, sql)
+	})
+
+	t.Run("should reject unsupported forms", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			stmt     *dml.InsertStatement
+			dialect  dialect.Dialect
+			expected string
+		}{
+			{
+				name:     "unsupported dialect",
+				stmt:     stmt,
+				dialect:  dialect.SQLServer(),
+				expected: "ON CONFLICT is not supported by the sqlserver dialect",
+			},
+			{
+				name:     "DO NOTHING on MySQL",
+				stmt:     dml.Insert(users, "id").Values(1).OnConflict().DoNothing(),
+				dialect:  dialect.MySQL(),
+				expected: "ON CONFLICT DO NOTHING is not supported by the mysql dialect",
+			},
+			{
+				name:     "DO UPDATE without a target",
+				stmt:     dml.Insert(users, "id").Values(1).OnConflict().DoUpdateSet("id", sst.Excluded("id")),
+				dialect:  dialect.PostgreSQL(),
+				expected: "ON CONFLICT DO UPDATE requires a conflict target",
+			},
+			{
+				name:     "missing action",
+				stmt:     dml.Insert(users, "id").Values(1).OnConflict("id"),
+				dialect:  dialect.PostgreSQL(),
+				expected: "ON CONFLICT requires a DO NOTHING or DO UPDATE action",
+			},
+			{
+				name:     "EXCLUDED outside DO UPDATE",
+				stmt:     dml.Insert(users, "id").Values(sst.Excluded("id")),
+				dialect:  dialect.PostgreSQL(),
+				expected: `EXCLUDED column "id" is only valid in DO UPDATE SET assignments`,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, _, err := CompileWith(tt.stmt, tt.dialect)
+
+				assert.EqualError(t, err, tt.expected)
+			})
+		}
+	})
+}
+
+func TestCompileUpdate(t *testing.T) {
+	counter := sst.NewColumnRef("", "counter")
+	stmt := dml.Update(sst.NewTableRef("users")).
+		Set("counter", sst.Add(counter, sst.NewBindParam(1))).
+		Set("name", sst.Coalesce(sst.NewBindParam(nil), sst.NewColumnRef("", "name"))).
+		Where(sst.Eq(sst.NewColumnRef("", "id"), sst.NewBindParam(7)))
+
+	t.Run("should render SET expressions and WHERE", func(t *testing.T) {
+		sql, args, err := CompileWith(stmt, dialect.MySQL())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "UPDATE `users` SET `counter` = `counter` + ?, "+
+			"`name` = COALESCE(?, `name`) WHERE `id` = ?", sql)
+		assert.Equal(t, []any{1, nil, 7}, args)
+	})
+
+	t.Run("should render FROM sources and RETURNING", func(t *testing.T) {
+		u := sst.NewTableRef("users", sst.WithTableAlias("u"))
+		totals := sst.NewTableRef("order_totals", sst.WithTableAlias("t"))
+		stmt := dml.Update(u).
+			Set("total", totals.Column("amount")).
+			Set("updated_at", sst.Default()).
+			From(totals).
+			Where(sst.Eq(totals.Column("user_id"), u.Column("id"))).
+			Returning(u.Column("id"))
+
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "UPDATE users AS u SET total = t.amount, updated_at = DEFAULT "+
+			"FROM order_totals AS t WHERE t.user_id = u.id RETURNING u.id", sql)
+		assert.Empty(t, args)
+
+		_, _, err = CompileWith(stmt, dialect.MySQL())
+
+		assert.EqualError(t, err, "UPDATE ... FROM is not supported by the mysql dialect")
+	})
+
+	t.Run("should declare an aliased target among the SQL Server sources", func(t *testing.T) {
+		u := sst.NewTableRef("users", sst.WithTableAlias("u"))
+		totals := sst.NewTableRef("order_totals", sst.WithTableAlias("t"))
+		stmt := dml.Update(u).
+			Set("total", totals.Column("amount")).
+			From(totals).
+			Where(sst.Eq(totals.Column("user_id"), u.Column("id")))
+
+		sql, args, err := CompileWith(stmt, dialect.SQLServer())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "UPDATE [u] SET [total] = [t].[amount] "+
+			"FROM [users] AS [u], [order_totals] AS [t] WHERE [t].[user_id] = [u].[id]", sql)
+		assert.Empty(t, args)
+
+		stmt = dml.Update(u).
+			Set("active", false).
+			Where(sst.Eq(u.Column("id"), sst.NewBindParam(7)))
+
+		sql, args, err = CompileWith(stmt, dialect.SQLServer())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "UPDATE [u] SET [active] = @p1 FROM [users] AS [u] WHERE [u].[id] = @p2", sql)
+		assert.Equal(t, []any{false, 7}, args)
+
+		sql, _, err = CompileWith(dml.Update(sst.NewTableRef("users")).Set("active", false).AllRows(), dialect.SQLServer())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "UPDATE [users] SET [active] = @p1", sql)
+
+		_, _, err = CompileWith(stmt, dialect.New("plain"))
+
+		assert.EqualError(t, err, "aliased UPDATE targets are not supported by the plain dialect")
+	})
+
+	t.Run("should refuse updating all rows without opt-in", func(t *testing.T) {
+		stmt := dml.Update(sst.NewTableRef("users")).Set("active", false)
+
+		_, _, err := Compile(stmt)
+
+		assert.EqualError(t, err, "UPDATE without WHERE requires an explicit AllRows opt-in")
+
+		sql, args, err := Compile(stmt.AllRows())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "UPDATE users SET active = ?", sql)
+		assert.Equal(t, []any{false}, args)
+	})
+
+	t.Run("should require assignments", func(t *testing.T) {
+		_, _, err := Compile(dml.Update(sst.NewTableRef("users")).AllRows())
+
+		assert.EqualError(t, err, "UPDATE requires at least one SET assignment")
+	})
+}
+
+func TestCompileDelete(t *testing.T) {
+	s := sst.NewTableRef("sessions", sst.WithTableAlias("s"))
+	u := sst.NewTableRef("users", sst.WithTableAlias("u"))
+
+	t.Run("should render WHERE and RETURNING", func(t *testing.T) {
+		stmt := dml.Delete(sst.NewTableRef("sessions")).
+			Where(sst.Eq(sst.NewColumnRef("", "revoked"), sst.NewBindParam(true))).
+			Returning(sst.NewColumnRef("", "id"))
+
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL())
+
+		assert.NoError(t, err)
+		assert.Equal(t, `DELETE FROM "sessions" WHERE "revoked" = $1 RETURNING "id"`, sql)
+		assert.Equal(t, []any{true}, args)
+
+		_, _, err = CompileWith(stmt, dialect.MySQL())
+
+		assert.EqualError(t, err, "RETURNING is not supported by the mysql dialect")
+	})
+
+	t.Run("should render sources in the dialect form", func(t *testing.T) {
+		stmt := dml.Delete(s).
+			Using(u).
+			Where(sst.And(
+				sst.Eq(s.Column("user_id"), u.Column("id")),
+				sst.Eq(u.Column("active"), sst.NewBindParam(false)),
+			))
+
+		sql, args, err := CompileWith(stmt, dialect.PostgreSQL(
+			dialect.WithQuotePolicy(dialect.QuoteWhenNeeded),
+		))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "DELETE FROM sessions AS s USING users AS u "+
+			"WHERE s.user_id = u.id AND u.active = $1", sql)
+		assert.Equal(t, []any{false}, args)
+
+		sql, args, err = CompileWith(stmt, dialect.MySQL())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "DELETE `s` FROM `sessions` AS `s`, `users` AS `u` "+
+			"WHERE `s`.`user_id` = `u`.`id` AND `u`.`active` = ?", sql)
+		assert.Equal(t, []any{false}, args)
+
+		_, _, err = CompileWith(stmt, dialect.SQLite())
+
+		assert.EqualError(t, err, "DELETE ... USING is not supported by the sqlite dialect")
+	})
+
+	t.Run("should target the table name without an alias", func(t *testing.T) {
+		sessions := sst.NewTableRef("sessions")
+		users := sst.NewTableRef("users")
+		stmt := dml.Delete(sessions).
+			Using(users).
+			Where(sst.Eq(sessions.Column("user_id"), users.Column("id")))
+
+		sql, _, err := CompileWith(stmt, dialect.SQLServer())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "DELETE [sessions] FROM [sessions], [users] "+
+			"WHERE [sessions].[user_id] = [users].[id]", sql)
+	})
+
+	t.Run("should declare an aliased target in the SQL Server FROM", func(t *testing.T) {
+		stmt := dml.Delete(s).Where(sst.Eq(s.Column("revoked"), sst.NewBindParam(true)))
+
+		sql, args, err := CompileWith(stmt, dialect.SQLServer())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "DELETE [s] FROM [sessions] AS [s] WHERE [s].[revoked] = @p1", sql)
+		assert.Equal(t, []any{true}, args)
+
+		_, _, err = CompileWith(stmt, dialect.New("plain"))
+
+		assert.EqualError(t, err, "aliased DELETE targets are not supported by the plain dialect")
+	})
+
+	t.Run("should refuse deleting all rows without opt-in", func(t *testing.T) {
+		stmt := dml.Delete(sst.NewTableRef("sessions"))
+
+		_, _, err := Compile(stmt)
+
+		assert.EqualError(t, err, "DELETE without WHERE requires an explicit AllRows opt-in")
+
+		sql, args, err := Compile(stmt.AllRows())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "DELETE FROM sessions", sql)
+		assert.Empty(t, args)
+	})
+
+	t.Run("should report construction errors", func(t *testing.T) {
+		_, _, err := Compile(dml.Delete(nil))
+
+		assert.EqualError(t, err, "DELETE table cannot be nil")
+	})
+}
@@ -0,0 +1,73 @@
+package dialect
+
+// Generic creates the portable default dialect used by compiler.Compile. It
+// renders ? placeholders, double-quotes identifiers only when needed, and
+// supports the standard SQL capabilities.
+func Generic(options ...Option) Dialect {
+	return New("generic", append([]Option{
+		WithCapabilities(FullOuterJoin | Lateral | IsDistinctFrom | EmptyInList |
+			LimitOffset | StandaloneOffset | NullsOrdering | ConcatOperator |
+			ParenthesizedSetOperands | RecursiveKeyword | DefaultKeyword | TargetAlias),
+	}, options...)...)
+}
+
+// PostgreSQL creates a PostgreSQL dialect with $n placeholders and
+// double-quoted identifiers. Built-in dialects quote every identifier unless
+// configured with WithQuotePolicy(QuoteWhenNeeded).
+func PostgreSQL(options ...Option) Dialect {
+	return New("postgresql", append([]Option{
+		WithPlaceholderStyle(DollarPlaceholder),
+		WithIdentifierQuotes(`"`, `"`),
+		WithQuotePolicy(QuoteAlways),
+		WithReservedWords(postgresReservedWords...),
+		WithCapabilities(Returning | FullOuterJoin | Lateral | ILike |
+			IsDistinctFrom | DistinctOn | EmptyInList | LimitOffset |
+			StandaloneOffset | OffsetFetch | NullsOrdering | ConcatOperator |
+			CastOperator | ParenthesizedSetOperands | RecursiveKeyword |
+			MaterializedCTE | DefaultKeyword | UpdateFrom | DeleteUsing |
+			OnConflict | ConflictConstraint | UnambiguousUpsertSelect | TargetAlias |
+			InsertTargetAlias),
+	}, options...)...)
+}
+
+// MySQL creates a MySQL dialect with ? placeholders and backtick-quoted
+// identifiers.
+func MySQL(options ...Option) Dialect {
+	return New("mysql", append([]Option{
+		WithIdentifierQuotes("`", "`"),
+		WithQuotePolicy(QuoteAlways),
+		WithReservedWords(mysqlReservedWords...),
+		WithCapabilities(Lateral | NullSafeEqual | BackslashEscapes | EmptyInList |
+			LimitOffset | ParenthesizedSetOperands | RecursiveKeyword |
+			ConditionlessJoin | DefaultKeyword | MultiTableDelete |
+			OnDuplicateKeyUpdate | TargetAlias),
+	}, options...)...)
+}
+
+// SQLite creates a SQLite dialect with ? placeholders and double-quoted
+// identifiers.
+func SQLite(options ...Option) Dialect {
+	return New("sqlite", append([]Option{
+		WithIdentifierQuotes(`"`, `"`),
+		WithQuotePolicy(QuoteAlways),
+		WithReservedWords(sqliteReservedWords...),
+		WithCapabilities(Returning | FullOuterJoin | IsDistinctFrom | EmptyInList |
+			LimitOffset | NullsOrdering | ConcatOperator | RecursiveKeyword |
+			MaterializedCTE | ConditionlessJoin | UpdateFrom | OnConflict |
+			TargetAlias | InsertTargetAlias),
+	}, options...)...)
+}
+
+// SQLServer creates a SQL Server dialect with @pN placeholders and
+// bracket-quoted identifiers.
+func SQLServer(options ...Option) Dialect {
+	return New("sqlserver", append([]Option{
+		WithPlaceholderStyle(AtPlaceholder),
+		WithIdentifierQuotes("[", "]"),
+		WithQuotePolicy(QuoteAlways),
+		WithReservedWords(sqlserverReservedWords...),
+		WithCapabilities(FullOuterJoin | IsDistinctFrom | EmptyInList |
+			OffsetFetch | Top | ParenthesizedSetOperands | DefaultKeyword |
+			UpdateFrom | MultiTableDelete),
+	}, options...)...)
+}
@@ -0,0 +1,288 @@
+package dialect
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+)
+
+// Dialect describes the database-specific rules consulted by the compiler
+// while rendering SQL: bind placeholders, identifier quoting, and the
+// capabilities supported by the target database.
+type Dialect interface {
+	// Name returns the dialect name used in compiler errors.
+	Name() string
+
+	// Bind returns the placeholder rendered for the bind value at the 1-based
+	// position and the argument collected for it. Named placeholder styles use
+	// name when it is not empty.
+	Bind(position int, name string, value any) (string, any)
+
+	// QuoteIdentifier renders one identifier part, such as a schema, table,
+	// or column name, escaping embedded quote characters.
+	QuoteIdentifier(name string) string
+
+	// IsReserved reports whether name is a reserved word for the dialect.
+	IsReserved(name string) bool
+
+	// Supports reports whether the dialect supports every capability in c.
+	Supports(c Capability) bool
+}
+
+// PlaceholderStyle identifies how bind parameters are rendered.
+type PlaceholderStyle uint8
+
+const (
+	// QuestionPlaceholder renders every bind parameter as ?.
+	QuestionPlaceholder PlaceholderStyle = iota
+	// DollarPlaceholder renders numbered placeholders such as $1.
+	DollarPlaceholder
+	// NamedPlaceholder renders named placeholders such as :name and collects
+	// sql.NamedArg arguments. Unnamed parameters are named p1, p2, and so on.
+	NamedPlaceholder
+	// AtPlaceholder renders numbered placeholders such as @p1.
+	AtPlaceholder
+)
+
+// QuotePolicy identifies when identifiers are wrapped in quote characters.
+type QuotePolicy uint8
+
+const (
+	// QuoteWhenNeeded quotes identifiers that are reserved words or contain
+	// characters outside lowercase letters, digits, and underscores.
+	QuoteWhenNeeded QuotePolicy = iota
+	// QuoteAlways quotes every identifier.
+	QuoteAlways
+)
+
+// Capability identifies optional SQL features a dialect may support.
+// Capabilities are bit flags and can be combined.
+type Capability uint64
+
+const (
+	// Returning allows a RETURNING clause on DML statements.
+	Returning Capability = 1 << iota
+	// FullOuterJoin allows FULL OUTER JOIN.
+	FullOuterJoin
+	// Lateral allows LATERAL derived tables.
+	Lateral
+	// ILike provides a native case-insensitive ILIKE operator.
+	ILike
+	// IsDistinctFrom provides native IS [NOT] DISTINCT FROM predicates.
+	IsDistinctFrom
+	// NullSafeEqual provides the <=> null-safe equality operator used to
+	// rewrite IS [NOT] DISTINCT FROM.
+	NullSafeEqual
+	// DistinctOn allows SELECT DISTINCT ON (...).
+	DistinctOn
+	// BackslashEscapes treats backslashes inside string literals as escape
+	// characters, so the compiler doubles them when rendering literals.
+	BackslashEscapes
+	// EmptyInList renders an empty IN list as a constant predicate, 1 = 0 for
+	// IN and 1 = 1 for NOT IN, instead of failing compilation.
+	EmptyInList
+	// LimitOffset renders row limits as trailing LIMIT and OFFSET clauses.
+	LimitOffset
+	// StandaloneOffset allows OFFSET without LIMIT.
+	StandaloneOffset
+	// OffsetFetch renders row limits as OFFSET n ROWS FETCH NEXT m ROWS ONLY
+	// after ORDER BY.
+	OffsetFetch
+	// Top renders a row limit on unordered queries as SELECT TOP (n).
+	Top
+	// NullsOrdering provides native NULLS FIRST and NULLS LAST in ORDER BY.
+	NullsOrdering
+	// ConcatOperator provides the standard || string concatenation operator.
+	// Dialects without it concatenate with CONCAT(...).
+	ConcatOperator
+	// CastOperator renders type conversions with the :: shorthand instead of
+	// CAST(... AS ...).
+	CastOperator
+	// ParenthesizedSetOperands allows parenthesized operands in UNION,
+	// INTERSECT, and EXCEPT, which operands with their own ORDER BY or row
+	// limit and nested set operations require.
+	ParenthesizedSetOperands
+	// RecursiveKeyword renders WITH RECURSIVE for recursive common table
+	// expressions. Dialects without it detect recursion themselves.
+	RecursiveKeyword
+	// MaterializedCTE allows MATERIALIZED and NOT MATERIALIZED hints on
+	// common table expressions.
+	MaterializedCTE
+	// ConditionlessJoin accepts an inner JOIN without ON or USING and
+	// evaluates it as a cross join instead of rejecting the statement.
+	ConditionlessJoin
+	// DefaultKeyword allows DEFAULT in place of a value in INSERT rows and
+	// UPDATE assignments.
+	DefaultKeyword
+	// UpdateFrom allows additional FROM sources in UPDATE statements.
+	UpdateFrom
+	// DeleteUsing allows additional sources in DELETE statements through
+	// DELETE FROM target USING sources.
+	DeleteUsing
+	// MultiTableDelete allows additional sources in DELETE statements through
+	// DELETE target FROM target, sources.
+	MultiTableDelete
+	// OnConflict allows INSERT conflict handling through ON CONFLICT.
+	OnConflict
+	// ConflictConstraint allows ON CONFLICT ON CONSTRAINT conflict targets.
+	ConflictConstraint
+	// OnDuplicateKeyUpdate allows INSERT conflict handling through
+	// ON DUPLICATE KEY UPDATE.
+	OnDuplicateKeyUpdate
+	// UnambiguousUpsertSelect allows ON CONFLICT after an INSERT source query
+	// that ends with its FROM clause. Without it, as in SQLite, the query
+	// needs a WHERE clause to tell the upsert apart from a join condition.
+	UnambiguousUpsertSelect
+	// TargetAlias allows an alias on the target table of UPDATE and DELETE
+	// statements. Without it, an aliased target is named by its alias and
+	// declared in the FROM sources, as in SQL Server.
+	TargetAlias
+	// InsertTargetAlias allows an alias on the target table of INSERT
+	// statements.
+	InsertTargetAlias
+)
+
+type spec struct {
+	name         string
+	placeholder  PlaceholderStyle
+	quoteOpen    string
+	quoteClose   string
+	quotePolicy  QuotePolicy
+	reserved     map[string]struct{}
+	capabilities Capability
+}
+
+var _ Dialect = (*spec)(nil)
+
+// Option configures a dialect during construction.
+type Option func(*spec)
+
+// New creates a dialect with the provided name and applies the provided
+// construction options. Without options the dialect renders ? placeholders,
+// double-quotes identifiers only when needed, and supports no optional
+// capability.
+func New(name string, options ...Option) Dialect {
+	d := &spec{
+		name:       name,
+		quoteOpen:  `"`,
+		quoteClose: `"`,
+		reserved:   make(map[string]struct{}, len(reservedWords)),
+	}
+	for _, word := range reservedWords {
+		d.reserved[word] = struct{}{}
+	}
+
+	for _, option := range options {
+		option(d)
+	}
+
+	return d
+}
+
+// WithPlaceholderStyle configures how bind parameters are rendered.
+func WithPlaceholderStyle(style PlaceholderStyle) Option {
+	return func(d *spec) {
+		d.placeholder = style
+	}
+}
+
+// WithIdentifierQuotes configures the opening and closing identifier quote
+// characters, such as `"` and `"` or `[` and `]`.
+func WithIdentifierQuotes(open, close string) Option {
+	return func(d *spec) {
+		d.quoteOpen = open
+		d.quoteClose = close
+	}
+}
+
+// WithQuotePolicy configures when identifiers are quoted.
+func WithQuotePolicy(policy QuotePolicy) Option {
+	return func(d *spec) {
+		d.quotePolicy = policy
+	}
+}
+
+// WithReservedWords adds words that must be quoted when used as identifiers
+// under the QuoteWhenNeeded policy. Words are matched case-insensitively.
+func WithReservedWords(words ...string) Option {
+	return func(d *spec) {
+		for _, word := range words {
+			d.reserved[strings.ToLower(word)] = struct{}{}
+		}
+	}
+}
+
+// WithCapabilities adds capabilities to the dialect.
+func WithCapabilities(c Capability) Option {
+	return func(d *spec) {
+		d.capabilities |= c
+	}
+}
+
+// WithoutCapabilities removes capabilities from the dialect.
+func WithoutCapabilities(c Capability) Option {
+	return func(d *spec) {
+		d.capabilities &^= c
+	}
+}
+
+// Name returns the dialect name.
+func (d *spec) Name() string {
+	return d.name
+}
+
+// Bind returns the placeholder and argument for the bind value at position.
+func (d *spec) Bind(position int, name string, value any) (string, any) {
+	switch d.placeholder {
+	case DollarPlaceholder:
+		return "$" + strconv.Itoa(position), value
+	case NamedPlaceholder:
+		if name == "" {
+			name = "p" + strconv.Itoa(position)
+		}
+		return ":" + name, sql.Named(name, value)
+	case AtPlaceholder:
+		return "@p" + strconv.Itoa(position), value
+	default:
+		return "?", value
+	}
+}
+
+// QuoteIdentifier wraps the identifier with the dialect quote characters
+// according to the quote policy. Embedded closing quote characters are
+// escaped by doubling them.
+func (d *spec) QuoteIdentifier(name string) string {
+	if d.quotePolicy == QuoteWhenNeeded && !d.needsQuotes(name) {
+		return name
+	}
+	if d.quoteClose != "" {
+		name = strings.ReplaceAll(name, d.quoteClose, d.quoteClose+d.quoteClose)
+	}
+	return d.quoteOpen + name + d.quoteClose
+}
+
+// IsReserved reports whether name is a reserved word for the dialect.
+func (d *spec) IsReserved(name string) bool {
+	_, ok := d.reserved[strings.ToLower(name)]
+	return ok
+}
+
+func (d *spec) needsQuotes(name string) bool {
+	if name == "" || d.IsReserved(name) {
+		return true
+	}
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r == '_':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Supports reports whether every capability in c is enabled.
+func (d *spec) Supports(c Capability) bool {
+	return d.capabilities&c == c
+}
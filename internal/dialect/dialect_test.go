@@ -0,0 +1,149 @@
+package dialect
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBindPlaceholderStyles(t *testing.T) {
+	tests := []struct {
+		name        string
+		dialect     Dialect
+		bindName    string
+		placeholder string
+		arg         any
+	}{
+		{
+			name:        "question",
+			dialect:     New("test"),
+			placeholder: "?",
+			arg:         42,
+		},
+		{
+			name:        "dollar",
+			dialect:     New("test", WithPlaceholderStyle(DollarPlaceholder)),
+			placeholder: "$3",
+			arg:         42,
+		},
+		{
+			name:        "named",
+			dialect:     New("test", WithPlaceholderStyle(NamedPlaceholder)),
+			bindName:    "id",
+			placeholder: ":id",
+			arg:         sql.Named("id", 42),
+		},
+		{
+			name:        "named without bind name",
+			dialect:     New("test", WithPlaceholderStyle(NamedPlaceholder)),
+			placeholder: ":p3",
+			arg:         sql.Named("p3", 42),
+		},
+		{
+			name:        "at",
+			dialect:     New("test", WithPlaceholderStyle(AtPlaceholder)),
+			placeholder: "@p3",
+			arg:         42,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			placeholder, arg := tt.dialect.Bind(3, tt.bindName, 42)
+
+			assert.Equal(t, tt.placeholder, placeholder)
+			assert.Equal(t, tt.arg, arg)
+		})
+	}
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	assert.Equal(t, "users", Generic().QuoteIdentifier("users"))
+	assert.Equal(t, `"users"`, PostgreSQL().QuoteIdentifier("users"))
+	assert.Equal(t, "`users`", MySQL().QuoteIdentifier("users"))
+	assert.Equal(t, `"users"`, SQLite().QuoteIdentifier("users"))
+	assert.Equal(t, "[users]", SQLServer().QuoteIdentifier("users"))
+}
+
+func TestQuoteIdentifierEscapesQuotes(t *testing.T) {
+	assert.Equal(t, `"a""b"`, PostgreSQL().QuoteIdentifier(`a"b`))
+	assert.Equal(t, "`a``b`", MySQL().QuoteIdentifier("a`b"))
+	assert.Equal(t, "[a]]b]", SQLServer().QuoteIdentifier("a]b"))
+	assert.Equal(
+		t,
+		`"id"" = 1; DROP TABLE users; --"`,
+		Generic().QuoteIdentifier(`id" = 1; DROP TABLE users; --`),
+	)
+}
+
+func TestQuoteIdentifierWhenNeeded(t *testing.T) {
+	d := PostgreSQL(WithQuotePolicy(QuoteWhenNeeded))
+
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "users", expected: "users"},
+		{name: "user_id2", expected: "user_id2"},
+		{name: "user", expected: `"user"`},
+		{name: "ORDER", expected: `"ORDER"`},
+		{name: "returning", expected: `"returning"`},
+		{name: "UserName", expected: `"UserName"`},
+		{name: "2fa", expected: `"2fa"`},
+		{name: "first name", expected: `"first name"`},
+		{name: "", expected: `""`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, d.QuoteIdentifier(tt.name))
+		})
+	}
+}
+
+func TestIsReserved(t *testing.T) {
+	assert.True(t, Generic().IsReserved("select"))
+	assert.True(t, Generic().IsReserved("Select"))
+	assert.False(t, Generic().IsReserved("key"))
+	assert.True(t, MySQL().IsReserved("key"))
+	assert.True(t, SQLServer().IsReserved("TOP"))
+	assert.True(t, New("test", WithReservedWords("Custom")).IsReserved("custom"))
+}
+
+func TestSupports(t *testing.T) {
+	d := New("test", WithCapabilities(Returning|ILike))
+
+	assert.True(t, d.Supports(Returning))
+	assert.True(t, d.Supports(Returning|ILike))
+	assert.False(t, d.Supports(Returning|DistinctOn))
+
+	d = PostgreSQL(WithoutCapabilities(Returning))
+
+	assert.False(t, d.Supports(Returning))
+	assert.True(t, d.Supports(ILike))
+	assert.False(t, MySQL().Supports(FullOuterJoin))
+	assert.True(t, SQLServer().Supports(OffsetFetch|Top))
+	assert.False(t, SQLServer().Supports(LimitOffset))
+	assert.True(t, MySQL().Supports(ConditionlessJoin))
+	assert.False(t, PostgreSQL().Supports(ConditionlessJoin))
+	assert.True(t, SQLServer().Supports(DefaultKeyword))
+	assert.False(t, SQLite().Supports(DefaultKeyword|Returning))
+	assert.True(t, SQLite().Supports(UpdateFrom))
+	assert.False(t, MySQL().Supports(UpdateFrom))
+	assert.True(t, SQLServer().Supports(UpdateFrom))
+	assert.False(t, SQLServer().Supports(TargetAlias))
+	assert.True(t, PostgreSQL().Supports(TargetAlias))
+	assert.True(t, SQLite().Supports(InsertTargetAlias))
+	assert.False(t, MySQL().Supports(InsertTargetAlias))
+	assert.True(t, PostgreSQL().Supports(DeleteUsing))
+	assert.True(t, MySQL().Supports(MultiTableDelete))
+	assert.False(t, SQLite().Supports(DeleteUsing|MultiTableDelete))
+	assert.True(t, PostgreSQL().Supports(OnConflict|ConflictConstraint))
+	assert.True(t, SQLite().Supports(OnConflict))
+	assert.False(t, SQLite().Supports(ConflictConstraint))
+	assert.True(t, PostgreSQL().Supports(UnambiguousUpsertSelect))
+	assert.False(t, SQLite().Supports(UnambiguousUpsertSelect))
+	assert.True(t, MySQL().Supports(OnDuplicateKeyUpdate))
+	assert.False(t, SQLServer().Supports(OnConflict|OnDuplicateKeyUpdate))
+}
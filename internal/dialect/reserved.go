@@ -0,0 +1,40 @@
+package dialect
+
+// reservedWords lists SQL keywords reserved by the SQL standard and by every
+// built-in dialect. Dialect-specific words are added with WithReservedWords.
+var reservedWords = []string{
+	"all", "and", "any", "as", "asc", "between", "both", "by", "case",
+	"cast", "check", "collate", "column", "constraint", "create", "cross",
+	"current_date", "current_time", "current_timestamp", "current_user",
+	"default", "delete", "desc", "distinct", "drop", "else", "end", "except",
+	"exists", "false", "fetch", "for", "foreign", "from", "full", "grant",
+	"group", "having", "in", "inner", "insert", "intersect", "into", "is",
+	"join", "lateral", "leading", "left", "like", "limit", "natural", "not",
+	"null", "offset", "on", "or", "order", "outer", "primary", "references",
+	"right", "select", "session_user", "set", "some", "table", "then", "to",
+	"trailing", "true", "union", "unique", "update", "user", "using",
+	"values", "when", "where", "with",
+}
+
+var postgresReservedWords = []string{
+	"analyse", "analyze", "array", "asymmetric", "do", "only", "placing",
+	"returning", "symmetric", "variadic", "window",
+}
+
+var mysqlReservedWords = []string{
+	"database", "databases", "div", "dual", "index", "interval", "key",
+	"keys", "match", "mod", "range", "rank", "read", "regexp", "rlike",
+	"row", "rows", "schema", "show", "usage", "write", "xor",
+}
+
+var sqliteReservedWords = []string{
+	"autoincrement", "escape", "glob", "index", "indexed", "isnull",
+	"notnull", "raise", "regexp",
+}
+
+var sqlserverReservedWords = []string{
+	"backup", "browse", "clustered", "database", "file", "identity",
+	"index", "key", "nonclustered", "open", "percent", "pivot", "plan",
+	"proc", "procedure", "rowcount", "schema", "top", "tran", "transaction",
+	"unpivot",
+}
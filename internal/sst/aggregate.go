@@ -0,0 +1,160 @@
+package sst
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AggregateFunction identifies a SQL aggregate function.
+type AggregateFunction string
+
+const (
+	CountFunction AggregateFunction = "COUNT"
+	SumFunction   AggregateFunction = "SUM"
+	AvgFunction   AggregateFunction = "AVG"
+	MinFunction   AggregateFunction = "MIN"
+	MaxFunction   AggregateFunction = "MAX"
+)
+
+// AggregateExpressionNode represents an aggregate function applied to one
+// argument, or to every row for COUNT(*).
+type AggregateExpressionNode interface {
+	ExpressionNode
+
+	// Function returns the aggregate function.
+	Function() AggregateFunction
+
+	// Argument returns the aggregated expression, or nil for COUNT(*).
+	Argument() ExpressionNode
+
+	// Distinct reports whether only distinct argument values are aggregated.
+	Distinct() bool
+}
+
+// AggregateExpression represents FUNCTION([DISTINCT] argument) or COUNT(*).
+type AggregateExpression struct {
+	function AggregateFunction
+	argument ExpressionNode
+	distinct bool
+}
+
+var _ AggregateExpressionNode = (*AggregateExpression)(nil)
+
+// AggregateOption configures an aggregate expression during construction.
+type AggregateOption func(*AggregateExpression)
+
+// NewAggregateExpression creates an aggregate expression over argument and
+// applies the provided construction options. A nil argument aggregates every
+// row and is only valid for COUNT.
+func NewAggregateExpression(function AggregateFunction, argument ExpressionNode, options ...AggregateOption) *AggregateExpression {
+	e := &AggregateExpression{
+		function: function,
+		argument: argument,
+	}
+
+	for _, option := range options {
+		option(e)
+	}
+
+	return e
+}
+
+// WithDistinct aggregates only distinct argument values.
+func WithDistinct() AggregateOption {
+	return func(e *AggregateExpression) {
+		e.distinct = true
+	}
+}
+
+// Count creates a COUNT(argument) expression.
+func Count(argument ExpressionNode, options ...AggregateOption) *AggregateExpression {
+	return NewAggregateExpression(CountFunction, argument, options...)
+}
+
+// CountAll creates a COUNT(*) expression.
+func CountAll() *AggregateExpression {
+	return NewAggregateExpression(CountFunction, nil)
+}
+
+// CountDistinct creates a COUNT(DISTINCT argument) expression.
+func CountDistinct(argument ExpressionNode) *AggregateExpression {
+	return NewAggregateExpression(CountFunction, argument, WithDistinct())
+}
+
+// Sum creates a SUM(argument) expression.
+func Sum(argument ExpressionNode, options ...AggregateOption) *AggregateExpression {
+	return NewAggregateExpression(SumFunction, argument, options...)
+}
+
+// Avg creates an AVG(argument) expression.
+func Avg(argument ExpressionNode, options ...AggregateOption) *AggregateExpression {
+	return NewAggregateExpression(AvgFunction, argument, options...)
+}
+
+// Min creates a MIN(argument) expression.
+func Min(argument ExpressionNode, options ...AggregateOption) *AggregateExpression {
+	return NewAggregateExpression(MinFunction, argument, options...)
+}
+
+// Max creates a MAX(argument) expression.
+func Max(argument ExpressionNode, options ...AggregateOption) *AggregateExpression {
+	return NewAggregateExpression(MaxFunction, argument, options...)
+}
+
+// Expr returns the aggregate function name.
+func (e *AggregateExpression) Expr() string {
+	return string(e.function)
+}
+
+// Accept dispatches the function name and traverses the grouped argument,
+// preceded by DISTINCT when requested. COUNT without an argument renders *.
+func (e *AggregateExpression) Accept(v Visitor) error {
+	switch e.function {
+	case CountFunction, SumFunction, AvgFunction, MinFunction, MaxFunction:
+	default:
+		return errors.New("unsupported aggregate function")
+	}
+	if e.argument == nil && e.function != CountFunction {
+		return fmt.Errorf("%s requires an argument", e.function)
+	}
+	if e.argument == nil && e.distinct {
+		return errors.New("COUNT(DISTINCT) requires an argument")
+	}
+
+	if err := v.VisitExpression(e); err != nil {
+		return err
+	}
+	if err := v.VisitExpressionGroupStart(); err != nil {
+		return err
+	}
+	if e.argument == nil {
+		if err := keyword("*").Accept(v); err != nil {
+			return err
+		}
+		return v.VisitExpressionGroupEnd()
+	}
+	if e.distinct {
+		if err := keyword("DISTINCT ").Accept(v); err != nil {
+			return err
+		}
+	}
+	if err := e.argument.Accept(v); err != nil {
+		return err
+	}
+	return v.VisitExpressionGroupEnd()
+}
+
+// Function returns the aggregate function.
+func (e *AggregateExpression) Function() AggregateFunction {
+	return e.function
+}
+
+// Argument returns the aggregated expression, or nil for COUNT(*).
+func (e *AggregateExpression) Argument() ExpressionNode {
+	return e.argument
+}
+
+// Distinct reports whether only distinct argument values are aggregated.
+func (e *AggregateExpression) Distinct() bool {
+	return e.distinct
+}
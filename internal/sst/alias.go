@@ -0,0 +1,63 @@
+package sst
+
+import "errors"
+
+// AliasedExpressionNode represents a projected expression with an output
+// column name.
+type AliasedExpressionNode interface {
+	ExpressionNode
+
+	// Expression returns the aliased expression.
+	Expression() ExpressionNode
+
+	// Alias returns the output column name.
+	Alias() string
+}
+
+// AliasedExpression represents expression AS alias.
+type AliasedExpression struct {
+	expr  ExpressionNode
+	alias string
+}
+
+var _ AliasedExpressionNode = (*AliasedExpression)(nil)
+
+// NewAliasedExpression creates an aliased projection of expr.
+func NewAliasedExpression(expr ExpressionNode, alias string) *AliasedExpression {
+	return &AliasedExpression{
+		expr:  expr,
+		alias: alias,
+	}
+}
+
+// As creates an expression AS alias projection.
+func As(expr ExpressionNode, alias string) *AliasedExpression {
+	return NewAliasedExpression(expr, alias)
+}
+
+// Expr returns the AS keyword and the unquoted alias.
+func (e *AliasedExpression) Expr() string {
+	return " AS " + e.alias
+}
+
+// Accept traverses the expression and then dispatches the alias, which
+// visitors render as an identifier.
+func (e *AliasedExpression) Accept(v Visitor) error {
+	if e.expr == nil {
+		return errors.New("AS requires an expression")
+	}
+	if err := e.expr.Accept(v); err != nil {
+		return err
+	}
+	return v.VisitExpression(e)
+}
+
+// Expression returns the aliased expression.
+func (e *AliasedExpression) Expression() ExpressionNode {
+	return e.expr
+}
+
+// Alias returns the output column name.
+func (e *AliasedExpression) Alias() string {
+	return e.alias
+}
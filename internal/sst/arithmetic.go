@@ -0,0 +1,170 @@
+package sst
+
+import "errors"
+
+// ArithmeticExpressionNode represents an arithmetic operation between two
+// expressions.
+type ArithmeticExpressionNode interface {
+	ExpressionNode
+	Left() ExpressionNode
+	Operator() ArithmeticOperator
+	Right() ExpressionNode
+}
+
+// ArithmeticExpression represents left op right for +, -, *, / and %.
+type ArithmeticExpression struct {
+	left  ExpressionNode
+	op    ArithmeticOperator
+	right ExpressionNode
+}
+
+var _ ArithmeticExpressionNode = (*ArithmeticExpression)(nil)
+
+// NewArithmeticExpression creates an arithmetic expression with the provided
+// operands and operator.
+func NewArithmeticExpression(left, right ExpressionNode, op ArithmeticOperator) *ArithmeticExpression {
+	return &ArithmeticExpression{
+		left:  left,
+		op:    op,
+		right: right,
+	}
+}
+
+// Add creates a left + right expression.
+func Add(left, right ExpressionNode) *ArithmeticExpression {
+	return NewArithmeticExpression(left, right, AddOperator)
+}
+
+// Sub creates a left - right expression.
+func Sub(left, right ExpressionNode) *ArithmeticExpression {
+	return NewArithmeticExpression(left, right, SubtractOperator)
+}
+
+// Mul creates a left * right expression.
+func Mul(left, right ExpressionNode) *ArithmeticExpression {
+	return NewArithmeticExpression(left, right, MultiplyOperator)
+}
+
+// Div creates a left / right expression.
+func Div(left, right ExpressionNode) *ArithmeticExpression {
+	return NewArithmeticExpression(left, right, DivideOperator)
+}
+
+// Mod creates a left % right expression.
+func Mod(left, right ExpressionNode) *ArithmeticExpression {
+	return NewArithmeticExpression(left, right, ModuloOperator)
+}
+
+// Expr returns the operator token for the arithmetic expression.
+func (e *ArithmeticExpression) Expr() string {
+	return " " + string(e.op) + " "
+}
+
+func (e *ArithmeticExpression) precedence() int {
+	switch e.op {
+	case MultiplyOperator, DivideOperator, ModuloOperator:
+		return multiplicativeExpressionPrecedence
+	default:
+		return additiveExpressionPrecedence
+	}
+}
+
+// Accept traverses the operands and dispatches the operator between them.
+// Operators associate to the left, so a left operand is grouped only when it
+// binds looser than the operator and a right operand also when it binds
+// equally, as in a - (b - c).
+func (e *ArithmeticExpression) Accept(v Visitor) error {
+	switch e.op {
+	case AddOperator,
+		SubtractOperator,
+		MultiplyOperator,
+		DivideOperator,
+		ModuloOperator:
+	default:
+		return errors.New("unsupported arithmetic operator")
+	}
+	if e.left == nil || e.right == nil {
+		return errors.New("arithmetic expression requires two operands")
+	}
+
+	if err := acceptGrouped(v, e.left, expressionPrecedence(e.left) < e.precedence()); err != nil {
+		return err
+	}
+	if err := v.VisitExpression(e); err != nil {
+		return err
+	}
+	return acceptGrouped(v, e.right, expressionPrecedence(e.right) <= e.precedence())
+}
+
+// Left returns the left expression operand.
+func (e *ArithmeticExpression) Left() ExpressionNode {
+	return e.left
+}
+
+// Right returns the right expression operand.
+func (e *ArithmeticExpression) Right() ExpressionNode {
+	return e.right
+}
+
+// Operator returns the arithmetic operator.
+func (e *ArithmeticExpression) Operator() ArithmeticOperator {
+	return e.op
+}
+
+// ConcatExpressionNode represents string concatenation of two or more
+// expressions. The operator differs per dialect, so visitors own the traversal
+// of its operands.
+type ConcatExpressionNode interface {
+	ExpressionNode
+
+	// Operands returns the concatenated expressions in order.
+	Operands() []ExpressionNode
+}
+
+// ConcatExpression represents a || b || ... or its dialect equivalent.
+type ConcatExpression struct {
+	operands []ExpressionNode
+}
+
+var _ ConcatExpressionNode = (*ConcatExpression)(nil)
+
+// Concat creates a string concatenation of the provided operands.
+func Concat(operands ...ExpressionNode) *ConcatExpression {
+	return &ConcatExpression{
+		operands: append([]ExpressionNode(nil), operands...),
+	}
+}
+
+// AcceptConcatOperand traverses an operand of an infix concatenation, grouping
+// operands that do not bind tighter than the concatenation operator.
+func AcceptConcatOperand(v Visitor, operand ExpressionNode) error {
+	return acceptGrouped(v, operand, expressionPrecedence(operand) <= concatExpressionPrecedence)
+}
+
+// Expr returns the standard concatenation operator token.
+func (e *ConcatExpression) Expr() string {
+	return " || "
+}
+
+func (e *ConcatExpression) precedence() int {
+	return concatExpressionPrecedence
+}
+
+// Accept validates the operands and dispatches the concatenation to the
+// visitor, which renders the dialect form.
+func (e *ConcatExpression) Accept(v Visitor) error {
+	if len(e.operands) < 2 {
+		return errors.New("concatenation requires at least two expressions")
+	}
+	for _, operand := range e.operands {
+		if operand == nil {
+			return errors.New("concatenation operand cannot be nil")
+		}
+	}
+	return v.VisitConcat(e)
+}
+
+// Operands returns the concatenated expressions in order.
+func (e *ConcatExpression) Operands() []ExpressionNode {
+	return e.operands
+}
@@ -0,0 +1,129 @@
+package sst
+
+import "errors"
+
+// CaseBranch is one WHEN ... THEN pair of a CASE expression. When holds a
+// condition in a searched CASE and a compared value in a simple CASE.
+type CaseBranch struct {
+	When ExpressionNode
+	Then ExpressionNode
+}
+
+// CaseExpressionNode represents a searched or simple CASE expression.
+type CaseExpressionNode interface {
+	ExpressionNode
+
+	// Operand returns the compared expression of a simple CASE, or nil for a
+	// searched CASE.
+	Operand() ExpressionNode
+
+	// Branches returns the WHEN ... THEN pairs in order.
+	Branches() []CaseBranch
+
+	// ElseResult returns the ELSE result, or nil when omitted.
+	ElseResult() ExpressionNode
+}
+
+// CaseExpression represents CASE [operand] WHEN ... THEN ... [ELSE ...] END.
+// CASE is delimited by its own keywords, so it never needs grouping as an
+// operand and its branches never need grouping inside it.
+type CaseExpression struct {
+	operand    ExpressionNode
+	branches   []CaseBranch
+	elseResult ExpressionNode
+}
+
+var _ CaseExpressionNode = (*CaseExpression)(nil)
+
+// Case creates a searched CASE expression whose branches are added with When.
+func Case() *CaseExpression {
+	return &CaseExpression{}
+}
+
+// CaseOf creates a simple CASE expression comparing operand with the value
+// of each branch added with When.
+func CaseOf(operand ExpressionNode) *CaseExpression {
+	return &CaseExpression{operand: operand}
+}
+
+// When appends a WHEN ... THEN branch and returns the expression.
+func (e *CaseExpression) When(when, then ExpressionNode) *CaseExpression {
+	e.branches = append(e.branches, CaseBranch{When: when, Then: then})
+	return e
+}
+
+// Else sets the ELSE result and returns the expression.
+func (e *CaseExpression) Else(result ExpressionNode) *CaseExpression {
+	e.elseResult = result
+	return e
+}
+
+// Expr returns the CASE keyword.
+func (e *CaseExpression) Expr() string {
+	return "CASE"
+}
+
+// Accept dispatches the CASE keyword and traverses the operand, each branch,
+// and the ELSE result in SQL order, so bind arguments keep their position
+// across branches.
+func (e *CaseExpression) Accept(v Visitor) error {
+	if len(e.branches) == 0 {
+		return errors.New("CASE requires at least one WHEN branch")
+	}
+	for _, branch := range e.branches {
+		if branch.When == nil || branch.Then == nil {
+			return errors.New("CASE branch requires WHEN and THEN expressions")
+		}
+	}
+
+	if err := v.VisitExpression(e); err != nil {
+		return err
+	}
+	if e.operand != nil {
+		if err := keyword(" ").Accept(v); err != nil {
+			return err
+		}
+		if err := e.operand.Accept(v); err != nil {
+			return err
+		}
+	}
+	for _, branch := range e.branches {
+		if err := keyword(" WHEN ").Accept(v); err != nil {
+			return err
+		}
+		if err := branch.When.Accept(v); err != nil {
+			return err
+		}
+		if err := keyword(" THEN ").Accept(v); err != nil {
+			return err
+		}
+		if err := branch.Then.Accept(v); err != nil {
+			return err
+		}
+	}
+	if e.elseResult != nil {
+		if err := keyword(" ELSE ").Accept(v); err != nil {
+			return err
+		}
+		if err := e.elseResult.Accept(v); err != nil {
+			return err
+		}
+	}
+	return keyword(" END").Accept(v)
+}
+
+// Operand returns the compared expression of a simple CASE, or nil for a
+// searched CASE.
+func (e *CaseExpression) Operand() ExpressionNode {
+	return e.operand
+}
+
+// Branches returns the WHEN ... THEN pairs in order.
+func (e *CaseExpression) Branches() []CaseBranch {
+	return e.branches
+}
+
+// ElseResult returns the ELSE result, or nil when omitted.
+func (e *CaseExpression) ElseResult() ExpressionNode {
+	return e.elseResult
+}
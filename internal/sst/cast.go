@@ -0,0 +1,183 @@
+package sst
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// CastExpressionNode represents a conversion of an expression to a SQL type.
+// The syntax differs per dialect, so visitors own the traversal of its operand.
+type CastExpressionNode interface {
+	ExpressionNode
+
+	// Operand returns the converted expression.
+	Operand() ExpressionNode
+
+	// Type returns the target SQL type name.
+	Type() string
+}
+
+// CastExpression represents CAST(operand AS type) or operand::type. Type names
+// are rendered as written, so they use the same strings stored in
+// schema.Field.Type, such as int, varchar(255), or numeric(10, 2).
+type CastExpression struct {
+	operand ExpressionNode
+	sqlType string
+}
+
+var _ CastExpressionNode = (*CastExpression)(nil)
+
+// NewCastExpression creates a conversion of operand to sqlType.
+func NewCastExpression(operand ExpressionNode, sqlType string) *CastExpression {
+	return &CastExpression{
+		operand: operand,
+		sqlType: sqlType,
+	}
+}
+
+// Cast creates a CAST(operand AS sqlType) expression.
+func Cast(operand ExpressionNode, sqlType string) *CastExpression {
+	return NewCastExpression(operand, sqlType)
+}
+
+// AcceptCastOperand traverses the operand of a postfix type conversion,
+// grouping every operand that is not atomic.
+func AcceptCastOperand(v Visitor, operand ExpressionNode) error {
+	return acceptGrouped(v, operand, expressionPrecedence(operand) < atomicExpressionPrecedence)
+}
+
+// Expr returns the standard CAST keyword.
+func (e *CastExpression) Expr() string {
+	return "CAST"
+}
+
+// Accept validates the conversion and dispatches it to the visitor, which
+// renders the dialect form.
+func (e *CastExpression) Accept(v Visitor) error {
+	if e.operand == nil {
+		return errors.New("CAST requires an expression")
+	}
+	if err := ValidateSQLType(e.sqlType); err != nil {
+		return err
+	}
+	return v.VisitCast(e)
+}
+
+// Operand returns the converted expression.
+func (e *CastExpression) Operand() ExpressionNode {
+	return e.operand
+}
+
+// Type returns the target SQL type name.
+func (e *CastExpression) Type() string {
+	return e.sqlType
+}
+
+// ValidateSQLType reports whether sqlType can be rendered as written. Type
+// names are space-separated words, optionally schema-qualified with dots,
+// with at most one numeric (p) or (p, s) list and an optional trailing [],
+// which covers forms such as double precision, numeric(10, 2),
+// timestamp(3) with time zone, varchar(max), and int[]. Words after the first
+// must be known type modifiers, so a type name can never extend into an
+// expression such as int OR true.
+func ValidateSQLType(sqlType string) error {
+	if sqlType == "" {
+		return errors.New("SQL type cannot be empty")
+	}
+	invalid := fmt.Errorf("invalid SQL type %q", sqlType)
+	rest := sqlType
+	hasParams := false
+	for words := 0; ; words++ {
+		var word string
+		word, rest = cutTypeWord(rest)
+		if word == "" {
+			return invalid
+		}
+		lower := strings.ToLower(word)
+		if _, ok := sqlTypeKeywords[lower]; ok {
+			return invalid
+		}
+		if _, ok := sqlTypeModifiers[lower]; words > 0 && !ok {
+			return invalid
+		}
+		if strings.HasPrefix(rest, "(") {
+			params, tail, ok := strings.Cut(rest[1:], ")")
+			if hasParams || !ok || !validTypeParams(params) {
+				return invalid
+			}
+			hasParams = true
+			rest = tail
+		}
+		switch {
+		case rest == "", rest == "[]":
+			return nil
+		case strings.HasPrefix(rest, " "):
+			rest = rest[1:]
+		default:
+			return invalid
+		}
+	}
+}
+
+// sqlTypeKeywords lists expression keywords that can never be part of a type
+// name.
+var sqlTypeKeywords = map[string]struct{}{
+	"and": {}, "or": {}, "not": {}, "is": {}, "in": {}, "like": {},
+	"between": {}, "collate": {}, "select": {}, "union": {},
+}
+
+// sqlTypeModifiers lists the words that may follow the first word of a
+// multi-word type name.
+var sqlTypeModifiers = map[string]struct{}{
+	"precision": {}, "varying": {}, "with": {}, "without": {}, "time": {},
+	"zone": {}, "signed": {}, "unsigned": {}, "integer": {}, "int": {},
+	"char": {}, "character": {}, "varchar": {}, "to": {}, "year": {},
+	"month": {}, "day": {}, "hour": {}, "minute": {}, "second": {},
+}
+
+// cutTypeWord splits a leading, optionally dot-qualified word from s. The
+// word is empty when s does not start with one.
+func cutTypeWord(s string) (string, string) {
+	end := 0
+	for end < len(s) {
+		c := s[end]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '.') {
+			break
+		}
+		end++
+	}
+	word := s[:end]
+	for _, part := range strings.Split(word, ".") {
+		if part == "" || !(part[0] >= 'a' && part[0] <= 'z' || part[0] >= 'A' && part[0] <= 'Z') {
+			return "", s
+		}
+	}
+	return word, s[end:]
+}
+
+// validTypeParams reports whether params is a p or p, s list of numbers, or
+// the single MAX length.
+func validTypeParams(params string) bool {
+	parts := strings.Split(params, ",")
+	if len(parts) > 2 {
+		return false
+	}
+	for i, part := range parts {
+		if i > 0 {
+			part = strings.TrimPrefix(part, " ")
+		}
+		if len(parts) == 1 && strings.EqualFold(part, "max") {
+			return true
+		}
+		if part == "" {
+			return false
+		}
+		for _, r := range part {
+			if r < '0' || r > '9' {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,229 @@
+package sst
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CTEMaterialization identifies the materialization hint of a common table
+// expression.
+type CTEMaterialization uint8
+
+const (
+	// MaterializeDefault leaves materialization to the database.
+	MaterializeDefault CTEMaterialization = iota
+	Materialized
+	NotMaterialized
+)
+
+// CommonTableExpressionNode represents one named query of a WITH clause. It
+// is also a TableRefNode, so the name can be used as a FROM or JOIN source
+// of the statement declaring it.
+type CommonTableExpressionNode interface {
+	TableRefNode
+
+	// Columns returns the optional output column names.
+	Columns() []string
+
+	// Query returns the named query, a SELECT or a set operation.
+	Query() StatementNode
+
+	// Recursive reports whether the query references its own name.
+	Recursive() bool
+
+	// Materialization returns the materialization hint.
+	Materialization() CTEMaterialization
+}
+
+// WithClauseNode represents the WITH clause of a statement root. Its shape
+// depends on the dialect, so visitors own the traversal of its queries.
+type WithClauseNode interface {
+	ClauseNode
+
+	// Tables returns the common table expressions in declaration order.
+	Tables() []CommonTableExpressionNode
+
+	// Recursive reports whether any common table expression is recursive,
+	// which makes the whole clause WITH RECURSIVE.
+	Recursive() bool
+}
+
+// CommonTableExpression represents name [(columns...)] AS [hint] (query).
+type CommonTableExpression struct {
+	name            string
+	columns         []string
+	query           StatementNode
+	recursive       bool
+	materialization CTEMaterialization
+}
+
+var _ CommonTableExpressionNode = (*CommonTableExpression)(nil)
+
+// CTEOption configures a common table expression during construction.
+type CTEOption func(*CommonTableExpression)
+
+// NewCommonTableExpression creates a named query and applies the provided
+// construction options.
+func NewCommonTableExpression(name string, query StatementNode, options ...CTEOption) *CommonTableExpression {
+	cte := &CommonTableExpression{
+		name:  name,
+		query: query,
+	}
+
+	for _, option := range options {
+		option(cte)
+	}
+
+	return cte
+}
+
+// CTE creates a named query for a WITH clause.
+func CTE(name string, query StatementNode, options ...CTEOption) *CommonTableExpression {
+	return NewCommonTableExpression(name, query, options...)
+}
+
+// WithCTEColumns names the output columns of a common table expression.
+func WithCTEColumns(columns ...string) CTEOption {
+	return func(cte *CommonTableExpression) {
+		cte.columns = append([]string(nil), columns...)
+	}
+}
+
+// WithRecursive marks a common table expression whose query references its
+// own name, usually as the right operand of a UNION ALL.
+func WithRecursive() CTEOption {
+	return func(cte *CommonTableExpression) {
+		cte.recursive = true
+	}
+}
+
+// WithMaterialized asks the database to compute the query once.
+func WithMaterialized() CTEOption {
+	return func(cte *CommonTableExpression) {
+		cte.materialization = Materialized
+	}
+}
+
+// WithNotMaterialized allows the database to inline the query into the
+// referencing statement.
+func WithNotMaterialized() CTEOption {
+	return func(cte *CommonTableExpression) {
+		cte.materialization = NotMaterialized
+	}
+}
+
+// Accept dispatches the name as a table reference. The definition is
+// rendered by the WITH clause.
+func (cte *CommonTableExpression) Accept(v Visitor) error {
+	return v.VisitTableRef(cte)
+}
+
+// Name returns the common table expression name.
+func (cte *CommonTableExpression) Name() string {
+	return cte.name
+}
+
+// Schema returns an empty string; common table expressions are unqualified.
+func (cte *CommonTableExpression) Schema() string {
+	return ""
+}
+
+// Alias returns an empty string; use Ref to reference the query under an
+// alias.
+func (cte *CommonTableExpression) Alias() string {
+	return ""
+}
+
+// Ref creates a table reference to the common table expression, such as an
+// aliased reference in a recursive join.
+func (cte *CommonTableExpression) Ref(options ...TableRefOption) *TableRef {
+	return NewTableRef(cte.name, options...)
+}
+
+// Column creates a column reference qualified by the common table expression
+// name.
+func (cte *CommonTableExpression) Column(name string) *ColumnRef {
+	return NewColumnRef(cte.name, name)
+}
+
+// Columns returns the optional output column names.
+func (cte *CommonTableExpression) Columns() []string {
+	return cte.columns
+}
+
+// Query returns the named query.
+func (cte *CommonTableExpression) Query() StatementNode {
+	return cte.query
+}
+
+// Recursive reports whether the query references its own name.
+func (cte *CommonTableExpression) Recursive() bool {
+	return cte.recursive
+}
+
+// Materialization returns the materialization hint.
+func (cte *CommonTableExpression) Materialization() CTEMaterialization {
+	return cte.materialization
+}
+
+// WithClause represents WITH [RECURSIVE] cte, ... ahead of a statement root.
+type WithClause struct {
+	tables []CommonTableExpressionNode
+}
+
+var _ WithClauseNode = (*WithClause)(nil)
+
+// NewWithClause creates a WITH clause declaring the provided common table
+// expressions in order.
+func NewWithClause(tables ...CommonTableExpressionNode) *WithClause {
+	return &WithClause{
+		tables: append([]CommonTableExpressionNode(nil), tables...),
+	}
+}
+
+// Declaration returns the WITH keyword.
+func (w *WithClause) Declaration() string {
+	return "WITH"
+}
+
+// Accept validates the declared queries and dispatches the clause to the
+// visitor.
+func (w *WithClause) Accept(v Visitor) error {
+	if len(w.tables) == 0 {
+		return errors.New("WITH requires at least one common table expression")
+	}
+	seen := make(map[string]struct{}, len(w.tables))
+	for _, cte := range w.tables {
+		if cte == nil {
+			return errors.New("common table expression cannot be nil")
+		}
+		if _, ok := seen[cte.Name()]; ok {
+			return fmt.Errorf("duplicate common table expression %q", cte.Name())
+		}
+		seen[cte.Name()] = struct{}{}
+
+		switch cte.Query().(type) {
+		case SelectStatementNode, SetOperationNode:
+		case nil:
+			return fmt.Errorf("common table expression %q requires a query", cte.Name())
+		default:
+			return fmt.Errorf("common table expression %q must be a SELECT or set operation", cte.Name())
+		}
+	}
+	return v.VisitWith(w)
+}
+
+// Tables returns the common table expressions in declaration order.
+func (w *WithClause) Tables() []CommonTableExpressionNode {
+	return w.tables
+}
+
+// Recursive reports whether any common table expression is recursive.
+func (w *WithClause) Recursive() bool {
+	for _, cte := range w.tables {
+		if cte.Recursive() {
+			return true
+		}
+	}
+	return false
+}
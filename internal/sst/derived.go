@@ -0,0 +1,111 @@
+package sst
+
+// DerivedTableNode represents a subquery used as a FROM or JOIN source under
+// an alias. It is a TableRefNode whose name is the alias, so column
+// references qualify against the alias. Its rendering depends on the dialect,
+// so visitors own the traversal of its query.
+type DerivedTableNode interface {
+	TableRefNode
+
+	// Query returns the source query, a SELECT or a set operation.
+	Query() StatementNode
+
+	// Columns returns the optional output column names.
+	Columns() []string
+
+	// Lateral reports whether the query may reference sources declared
+	// before it in the same FROM clause.
+	Lateral() bool
+}
+
+// DerivedTable represents [LATERAL] (query) AS alias [(columns...)].
+type DerivedTable struct {
+	query   StatementNode
+	alias   string
+	columns []string
+	lateral bool
+}
+
+var _ DerivedTableNode = (*DerivedTable)(nil)
+
+// DerivedTableOption configures a derived table during construction.
+type DerivedTableOption func(*DerivedTable)
+
+// NewDerivedTable creates a subquery source named alias and applies the
+// provided construction options.
+func NewDerivedTable(query StatementNode, alias string, options ...DerivedTableOption) *DerivedTable {
+	d := &DerivedTable{
+		query: query,
+		alias: alias,
+	}
+
+	for _, option := range options {
+		option(d)
+	}
+
+	return d
+}
+
+// Derived creates a (query) AS alias source.
+func Derived(query StatementNode, alias string, options ...DerivedTableOption) *DerivedTable {
+	return NewDerivedTable(query, alias, options...)
+}
+
+// Lateral creates a LATERAL (query) AS alias source.
+func Lateral(query StatementNode, alias string, options ...DerivedTableOption) *DerivedTable {
+	return NewDerivedTable(query, alias, append(options, WithLateral())...)
+}
+
+// WithDerivedColumns names the output columns of a derived table.
+func WithDerivedColumns(columns ...string) DerivedTableOption {
+	return func(d *DerivedTable) {
+		d.columns = append([]string(nil), columns...)
+	}
+}
+
+// WithLateral lets the query reference sources declared before it.
+func WithLateral() DerivedTableOption {
+	return func(d *DerivedTable) {
+		d.lateral = true
+	}
+}
+
+// Accept dispatches the derived table to the provided visitor.
+func (d *DerivedTable) Accept(v Visitor) error {
+	return v.VisitDerivedTable(d)
+}
+
+// Name returns the alias, which is how the source is referenced.
+func (d *DerivedTable) Name() string {
+	return d.alias
+}
+
+// Schema returns an empty string; derived tables are unqualified.
+func (d *DerivedTable) Schema() string {
+	return ""
+}
+
+// Alias returns the derived table alias.
+func (d *DerivedTable) Alias() string {
+	return d.alias
+}
+
+// Column creates a column reference qualified by the derived table alias.
+func (d *DerivedTable) Column(name string) *ColumnRef {
+	return NewColumnRef(d.alias, name)
+}
+
+// Query returns the source query.
+func (d *DerivedTable) Query() StatementNode {
+	return d.query
+}
+
+// Columns returns the optional output column names.
+func (d *DerivedTable) Columns() []string {
+	return d.columns
+}
+
+// Lateral reports whether the query may reference earlier sources.
+func (d *DerivedTable) Lateral() bool {
+	return d.lateral
+}
@@ -0,0 +1,164 @@
+package sst
+
+// InsertStatementNode represents the structural contract of an INSERT
+// statement after it has been built. Its shape depends on the dialect, so
+// visitors own the traversal of its parts.
+type InsertStatementNode interface {
+	StatementNode
+
+	// Table returns the target table.
+	Table() TableRefNode
+
+	// Columns returns the target column names, or nil when values follow the
+	// column order of the table.
+	Columns() []string
+
+	// Rows returns the VALUES rows in order, or nil when a query provides
+	// the rows.
+	Rows() []*ExpressionList
+
+	// Query returns the SELECT or set operation that provides the rows, or
+	// nil.
+	Query() StatementNode
+
+	// ConflictClause returns the conflict handling of an upsert, or nil.
+	ConflictClause() ConflictClauseNode
+
+	// ReturningClause returns the RETURNING clause, or nil.
+	ReturningClause() ReturningClauseNode
+}
+
+// UpdateStatementNode represents the structural contract of an UPDATE
+// statement after it has been built. Visitors own the traversal of its parts.
+type UpdateStatementNode interface {
+	StatementNode
+
+	// Table returns the target table.
+	Table() TableRefNode
+
+	// Assignments returns the SET assignments in order.
+	Assignments() []Assignment
+
+	// Sources returns the additional FROM sources, or nil.
+	Sources() []TableRefNode
+
+	// Condition returns the WHERE condition, or nil.
+	Condition() ExpressionNode
+
+	// AllowsAllRows reports whether the statement explicitly opted in to
+	// updating every row when it has no WHERE condition.
+	AllowsAllRows() bool
+
+	// ReturningClause returns the RETURNING clause, or nil.
+	ReturningClause() ReturningClauseNode
+}
+
+// DeleteStatementNode represents the structural contract of a DELETE
+// statement after it has been built. Visitors own the traversal of its parts.
+type DeleteStatementNode interface {
+	StatementNode
+
+	// Table returns the target table.
+	Table() TableRefNode
+
+	// Sources returns the additional sources the condition may reference,
+	// or nil.
+	Sources() []TableRefNode
+
+	// Condition returns the WHERE condition, or nil.
+	Condition() ExpressionNode
+
+	// AllowsAllRows reports whether the statement explicitly opted in to
+	// deleting every row when it has no WHERE condition.
+	AllowsAllRows() bool
+
+	// ReturningClause returns the RETURNING clause, or nil.
+	ReturningClause() ReturningClauseNode
+}
+
+// ConflictClauseNode represents the dialect-neutral conflict handling of an
+// INSERT statement. Dialects render it as ON CONFLICT or as ON DUPLICATE KEY
+// UPDATE, so visitors own its traversal.
+type ConflictClauseNode interface {
+	// Columns returns the conflict target columns, or nil.
+	Columns() []string
+
+	// Constraint returns the conflict target constraint name, or an empty
+	// string.
+	Constraint() string
+
+	// DoesNothing reports whether conflicting rows are skipped.
+	DoesNothing() bool
+
+	// AllowsAnyUniqueKey reports whether the statement explicitly accepts a
+	// conflict on any unique key where the dialect cannot target columns.
+	AllowsAnyUniqueKey() bool
+
+	// Assignments returns the DO UPDATE SET assignments in order, or nil.
+	Assignments() []Assignment
+}
+
+// Assignment is one column = value pair of an UPDATE SET clause or of the
+// DO UPDATE SET action of an upsert.
+type Assignment struct {
+	Column string
+	Value  ExpressionNode
+}
+
+// ReturningClauseNode represents the RETURNING clause of a DML statement.
+type ReturningClauseNode interface {
+	ClauseNode
+
+	// Expressions returns the returned expressions.
+	Expressions() *ExpressionList
+}
+
+// DefaultValue represents the DEFAULT keyword in place of a value, which
+// assigns the column default of the target table.
+type DefaultValue struct{}
+
+var _ ExpressionNode = (*DefaultValue)(nil)
+
+// Default creates a DEFAULT value for INSERT rows and UPDATE assignments.
+func Default() *DefaultValue {
+	return &DefaultValue{}
+}
+
+// Expr returns the DEFAULT keyword.
+func (e *DefaultValue) Expr() string {
+	return "DEFAULT"
+}
+
+// Accept dispatches the DEFAULT keyword to the visitor.
+func (e *DefaultValue) Accept(v Visitor) error {
+	return v.VisitExpression(e)
+}
+
+// ExcludedColumn references a column of the row proposed for insertion in the
+// DO UPDATE SET action of an upsert.
+type ExcludedColumn struct {
+	column string
+}
+
+var _ ExpressionNode = (*ExcludedColumn)(nil)
+
+// Excluded creates a reference to column of the row proposed for insertion.
+// It renders as EXCLUDED.column or as MySQL VALUES(column).
+func Excluded(column string) *ExcludedColumn {
+	return &ExcludedColumn{column: column}
+}
+
+// Column returns the referenced column name.
+func (e *ExcludedColumn) Column() string {
+	return e.column
+}
+
+// Expr returns the referenced column name.
+func (e *ExcludedColumn) Expr() string {
+	return e.column
+}
+
+// Accept dispatches the excluded column to the visitor.
+func (e *ExcludedColumn) Accept(v Visitor) error {
+	return v.VisitExpression(e)
+}
@@ -0,0 +1,35 @@
+package dml
+
+import (
+	"github.com/candango/sqlok/internal/sst"
+)
+
+type conflictClause struct {
+	columns      []string
+	constraint   string
+	doNothing    bool
+	anyUniqueKey bool
+	assignments  []sst.Assignment
+}
+
+var _ sst.ConflictClauseNode = (*conflictClause)(nil)
+
+func (c *conflictClause) Columns() []string {
+	return c.columns
+}
+
+func (c *conflictClause) Constraint() string {
+	return c.constraint
+}
+
+func (c *conflictClause) DoesNothing() bool {
+	return c.doNothing
+}
+
+func (c *conflictClause) AllowsAnyUniqueKey() bool {
+	return c.anyUniqueKey
+}
+
+func (c *conflictClause) Assignments() []sst.Assignment {
+	return c.assignments
+}
@@ -0,0 +1,149 @@
+package dml
+
+import (
+	"errors"
+
+	"github.com/candango/sqlok/internal/sst"
+)
+
+// DeleteStatement is the concrete builder and semantic root node of a
+// DELETE statement. A DELETE without a WHERE condition removes every row, so
+// it only compiles after an explicit AllRows opt-in.
+type DeleteStatement struct {
+	table     sst.TableRefNode
+	sources   []sst.TableRefNode
+	where     sst.ExpressionNode
+	allRows   bool
+	returning *returningClause
+	err       error
+}
+
+var _ sst.DeleteStatementNode = (*DeleteStatement)(nil)
+
+// Delete creates a DELETE builder for table.
+func Delete(table sst.TableRefNode) *DeleteStatement {
+	s := &DeleteStatement{}
+	if table == nil {
+		s.err = errors.New("DELETE table cannot be nil")
+		return s
+	}
+	if err := sst.ValidateIdentifiers(table); err != nil {
+		s.err = err
+		return s
+	}
+
+	s.table = table
+	return s
+}
+
+// Accept dispatches the DELETE statement to the provided visitor, which
+// renders the parts in the form its dialect requires.
+func (s *DeleteStatement) Accept(v sst.Visitor) error {
+	return v.VisitDelete(s)
+}
+
+// Declaration returns the DELETE keyword.
+func (s *DeleteStatement) Declaration() string {
+	return "DELETE"
+}
+
+// Err returns the first construction error recorded by the statement.
+// Once an error is recorded, subsequent builder operations are no-ops.
+func (s *DeleteStatement) Err() error {
+	return s.err
+}
+
+// Using appends sources the WHERE condition may reference. Dialects render
+// them as PostgreSQL DELETE ... USING or as the MySQL multi-table DELETE
+// syntax, and reject them when they support neither.
+func (s *DeleteStatement) Using(tables ...sst.TableRefNode) *DeleteStatement {
+	if s.err != nil {
+		return s
+	}
+	if len(tables) == 0 {
+		s.err = errors.New("DELETE USING requires at least one table")
+		return s
+	}
+	for _, table := range tables {
+		if table == nil {
+			s.err = errors.New("DELETE USING table cannot be nil")
+			return s
+		}
+		if err := sst.ValidateIdentifiers(table); err != nil {
+			s.err = err
+			return s
+		}
+	}
+
+	s.sources = append(s.sources, tables...)
+	return s
+}
+
+// Where adds a WHERE condition. Repeated calls combine conditions with AND.
+func (s *DeleteStatement) Where(condition sst.ExpressionNode) *DeleteStatement {
+	if s.err != nil {
+		return s
+	}
+	where, err := appendCondition(s.where, condition)
+	if err != nil {
+		s.err = err
+		return s
+	}
+
+	s.where = where
+	return s
+}
+
+// AllRows opts in to deleting every row when the statement has no WHERE
+// condition.
+func (s *DeleteStatement) AllRows() *DeleteStatement {
+	if s.err != nil {
+		return s
+	}
+	s.allRows = true
+	return s
+}
+
+// Returning sets the expressions returned for every deleted row. Only
+// dialects with the Returning capability compile it.
+func (s *DeleteStatement) Returning(exprs ...sst.ExpressionNode) *DeleteStatement {
+	if s.err != nil {
+		return s
+	}
+	returning, err := newReturningClause(exprs)
+	if err != nil {
+		s.err = err
+		return s
+	}
+
+	s.returning = returning
+	return s
+}
+
+// Table returns the target table.
+func (s *DeleteStatement) Table() sst.TableRefNode {
+	return s.table
+}
+
+// Sources returns the additional sources, or nil.
+func (s *DeleteStatement) Sources() []sst.TableRefNode {
+	return s.sources
+}
+
+// Condition returns the WHERE condition, or nil.
+func (s *DeleteStatement) Condition() sst.ExpressionNode {
+	return s.where
+}
+
+// AllowsAllRows reports whether AllRows was called.
+func (s *DeleteStatement) AllowsAllRows() bool {
+	return s.allRows
+}
+
+// ReturningClause returns the RETURNING clause, or nil.
+func (s *DeleteStatement) ReturningClause() sst.ReturningClauseNode {
+	if s.returning == nil {
+		return nil
+	}
+	return s.returning
+}
@@ -0,0 +1,71 @@
+package dml
+
+import (
+	"testing"
+
+	"github.com/candango/sqlok/internal/sst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteBuildsSourcesAndCondition(t *testing.T) {
+	users := sst.NewTableRef("users")
+	sessions := sst.NewTableRef("sessions")
+	stmt := Delete(sessions).
+		Using(users).
+		Where(sst.Eq(sessions.Column("user_id"), users.Column("id"))).
+		Where(sst.Eq(users.Column("active"), sst.NewBindParam(false)))
+
+	assert.NoError(t, stmt.Err())
+	assert.Equal(t, "DELETE", stmt.Declaration())
+	assert.Equal(t, []sst.TableRefNode{users}, stmt.Sources())
+	assert.IsType(t, &sst.LogicalExpression{}, stmt.Condition())
+	assert.False(t, stmt.AllowsAllRows())
+	assert.Nil(t, stmt.ReturningClause())
+	assert.True(t, Delete(sessions).AllRows().AllowsAllRows())
+}
+
+func TestDeleteRecordsErrors(t *testing.T) {
+	users := sst.NewTableRef("users")
+	tests := []struct {
+		name     string
+		stmt     *DeleteStatement
+		expected string
+	}{
+		{
+			name:     "nil table",
+			stmt:     Delete(nil),
+			expected: "DELETE table cannot be nil",
+		},
+		{
+			name:     "empty USING",
+			stmt:     Delete(users).Using(),
+			expected: "DELETE USING requires at least one table",
+		},
+		{
+			name:     "nil USING table",
+			stmt:     Delete(users).Using(nil),
+			expected: "DELETE USING table cannot be nil",
+		},
+		{
+			name:     "nil WHERE condition",
+			stmt:     Delete(users).Where(nil),
+			expected: "WHERE condition cannot be nil",
+		},
+		{
+			name:     "empty returning",
+			stmt:     Delete(users).Returning(),
+			expected: "RETURNING requires at least one expression",
+		},
+		{
+			name:     "first error wins",
+			stmt:     Delete(nil).Using().Where(nil),
+			expected: "DELETE table cannot be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.stmt.Err(), tt.expected)
+		})
+	}
+}
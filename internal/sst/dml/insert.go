@@ -0,0 +1,366 @@
+package dml
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/candango/sqlok/internal/sst"
+)
+
+// InsertStatement is the concrete builder and semantic root node of an
+// INSERT statement. Values that are not SST expressions are bound as
+// parameters, so rows never inline runtime input into SQL text.
+//
+// An INSERT becomes an upsert once OnConflict or OnConflictConstraint is
+// followed by DoNothing or DoUpdateSet.
+type InsertStatement struct {
+	table     sst.TableRefNode
+	columns   []string
+	rows      []*sst.ExpressionList
+	query     sst.StatementNode
+	conflict  *conflictClause
+	returning *returningClause
+	err       error
+}
+
+var _ sst.InsertStatementNode = (*InsertStatement)(nil)
+
+// Insert creates an INSERT builder for table and the optional target
+// columns. Without columns, every row must list values for all table columns
+// in their declared order.
+func Insert(table sst.TableRefNode, columns ...string) *InsertStatement {
+	s := &InsertStatement{}
+	if table == nil {
+		s.err = errors.New("INSERT table cannot be nil")
+		return s
+	}
+	if err := sst.ValidateIdentifiers(table); err != nil {
+		s.err = err
+		return s
+	}
+
+	seen := make(map[string]struct{}, len(columns))
+	for _, column := range columns {
+		if err := sst.ValidateIdentifier(column); err != nil {
+			s.err = fmt.Errorf("invalid INSERT column: %w", err)
+			return s
+		}
+		if _, ok := seen[column]; ok {
+			s.err = fmt.Errorf("duplicate INSERT column %q", column)
+			return s
+		}
+		seen[column] = struct{}{}
+	}
+
+	s.table = table
+	if len(columns) > 0 {
+		s.columns = append([]string(nil), columns...)
+	}
+	return s
+}
+
+// Accept dispatches the INSERT statement to the provided visitor, which
+// renders the parts in the form its dialect requires.
+func (s *InsertStatement) Accept(v sst.Visitor) error {
+	return v.VisitInsert(s)
+}
+
+// Declaration returns the INSERT INTO keywords.
+func (s *InsertStatement) Declaration() string {
+	return "INSERT INTO"
+}
+
+// Err returns the first construction error recorded by the statement.
+// Once an error is recorded, subsequent builder operations are no-ops.
+func (s *InsertStatement) Err() error {
+	return s.err
+}
+
+// Values appends one VALUES row. SST expressions, such as sst.Default(), are
+// kept as they are; any other value, including nil, is bound as a parameter.
+// Every row must have one value per target column, or as many values as the
+// first row when no columns are listed.
+func (s *InsertStatement) Values(values ...any) *InsertStatement {
+	if s.err != nil {
+		return s
+	}
+	if s.query != nil {
+		s.err = errors.New("INSERT cannot have both VALUES rows and a SELECT query")
+		return s
+	}
+	row, err := valueRow(values)
+	if err != nil {
+		s.err = err
+		return s
+	}
+	switch {
+	case len(s.columns) > 0 && len(values) != len(s.columns):
+		s.err = fmt.Errorf("VALUES row has %d values for %d columns", len(values), len(s.columns))
+		return s
+	case len(s.columns) == 0 && len(s.rows) > 0 && len(values) != len(s.rows[0].Items()):
+		s.err = errors.New("VALUES rows must have the same number of values")
+		return s
+	}
+
+	s.rows = append(s.rows, row)
+	return s
+}
+
+// Select sets a SELECT or set operation as the source of the inserted rows,
+// as in INSERT INTO table (columns) SELECT .... The query must project one
+// expression per target column when columns are listed, and its bind
+// arguments render in place. Combined with OnConflict, SQLite requires the
+// query to have a WHERE clause, such as WHERE true, to tell the upsert apart
+// from a join condition; the compiler rejects the query otherwise.
+func (s *InsertStatement) Select(query sst.StatementNode) *InsertStatement {
+	if s.err != nil {
+		return s
+	}
+	if query == nil {
+		s.err = errors.New("INSERT SELECT query cannot be nil")
+		return s
+	}
+	if s.query != nil {
+		s.err = errors.New("INSERT already has a SELECT query")
+		return s
+	}
+	if len(s.rows) > 0 {
+		s.err = errors.New("INSERT cannot have both VALUES rows and a SELECT query")
+		return s
+	}
+	if err := query.Err(); err != nil {
+		s.err = err
+		return s
+	}
+	projected, ok := projectedColumns(query)
+	if !ok {
+		s.err = errors.New("INSERT SELECT query must be a SELECT or set operation")
+		return s
+	}
+	if len(s.columns) > 0 && projected != len(s.columns) {
+		s.err = fmt.Errorf("SELECT query projects %d columns for %d INSERT columns", projected, len(s.columns))
+		return s
+	}
+	if err := sst.ValidateIdentifiers(query); err != nil {
+		s.err = err
+		return s
+	}
+
+	s.query = query
+	return s
+}
+
+// OnConflict starts the conflict handling of an upsert with the unique
+// columns that identify a conflicting row. Without columns, any unique
+// violation is a conflict. MySQL ON DUPLICATE KEY UPDATE cannot target
+// columns and applies to every unique key of the table, so it only compiles
+// columns after an explicit AnyUniqueKey opt-in.
+func (s *InsertStatement) OnConflict(columns ...string) *InsertStatement {
+	if s.err != nil {
+		return s
+	}
+	if s.conflict != nil {
+		s.err = errors.New("INSERT already has an ON CONFLICT clause")
+		return s
+	}
+	for _, column := range columns {
+		if err := sst.ValidateIdentifier(column); err != nil {
+			s.err = fmt.Errorf("invalid ON CONFLICT column: %w", err)
+			return s
+		}
+	}
+
+	s.conflict = &conflictClause{}
+	if len(columns) > 0 {
+		s.conflict.columns = append([]string(nil), columns...)
+	}
+	return s
+}
+
+// OnConflictConstraint starts the conflict handling of an upsert with the
+// name of the unique constraint that identifies a conflicting row. Only
+// dialects with the ConflictConstraint capability compile it.
+func (s *InsertStatement) OnConflictConstraint(name string) *InsertStatement {
+	if s.err != nil {
+		return s
+	}
+	if s.conflict != nil {
+		s.err = errors.New("INSERT already has an ON CONFLICT clause")
+		return s
+	}
+	if err := sst.ValidateIdentifier(name); err != nil {
+		s.err = fmt.Errorf("invalid ON CONFLICT constraint: %w", err)
+		return s
+	}
+
+	s.conflict = &conflictClause{constraint: name}
+	return s
+}
+
+// AnyUniqueKey accepts that dialects without conflict targets, such as MySQL,
+// handle a conflict on any unique key of the table rather than only on the
+// OnConflict columns.
+func (s *InsertStatement) AnyUniqueKey() *InsertStatement {
+	if s.err != nil {
+		return s
+	}
+	if s.conflict == nil {
+		s.err = errors.New("AnyUniqueKey requires an ON CONFLICT clause")
+		return s
+	}
+
+	s.conflict.anyUniqueKey = true
+	return s
+}
+
+// DoNothing skips rows that conflict with existing ones.
+func (s *InsertStatement) DoNothing() *InsertStatement {
+	if s.err != nil {
+		return s
+	}
+	if s.conflict == nil {
+		s.err = errors.New("DO NOTHING requires an ON CONFLICT clause")
+		return s
+	}
+	if len(s.conflict.assignments) > 0 {
+		s.err = errors.New("ON CONFLICT cannot both DO NOTHING and DO UPDATE")
+		return s
+	}
+
+	s.conflict.doNothing = true
+	return s
+}
+
+// DoUpdateSet appends a DO UPDATE SET assignment that runs against the
+// existing row on conflict. Values follow the rules of UPDATE Set, and
+// sst.Excluded references the row proposed for insertion.
+func (s *InsertStatement) DoUpdateSet(column string, value any) *InsertStatement {
+	if s.err != nil {
+		return s
+	}
+	if s.conflict == nil {
+		s.err = errors.New("DO UPDATE requires an ON CONFLICT clause")
+		return s
+	}
+	if s.conflict.doNothing {
+		s.err = errors.New("ON CONFLICT cannot both DO NOTHING and DO UPDATE")
+		return s
+	}
+	if err := sst.ValidateIdentifier(column); err != nil {
+		s.err = fmt.Errorf("invalid DO UPDATE SET column: %w", err)
+		return s
+	}
+	for _, assignment := range s.conflict.assignments {
+		if assignment.Column == column {
+			s.err = fmt.Errorf("duplicate DO UPDATE SET column %q", column)
+			return s
+		}
+	}
+	expr, err := valueExpression(value)
+	if err != nil {
+		s.err = err
+		return s
+	}
+
+	s.conflict.assignments = append(s.conflict.assignments, sst.Assignment{Column: column, Value: expr})
+	return s
+}
+
+// Returning sets the expressions returned for every inserted row. Only
+// dialects with the Returning capability compile it.
+func (s *InsertStatement) Returning(exprs ...sst.ExpressionNode) *InsertStatement {
+	if s.err != nil {
+		return s
+	}
+	returning, err := newReturningClause(exprs)
+	if err != nil {
+		s.err = err
+		return s
+	}
+
+	s.returning = returning
+	return s
+}
+
+// Table returns the target table.
+func (s *InsertStatement) Table() sst.TableRefNode {
+	return s.table
+}
+
+// Columns returns the target column names, or nil when none are listed.
+func (s *InsertStatement) Columns() []string {
+	return s.columns
+}
+
+// Rows returns the VALUES rows in order.
+func (s *InsertStatement) Rows() []*sst.ExpressionList {
+	return s.rows
+}
+
+// Query returns the source query of the inserted rows, or nil.
+func (s *InsertStatement) Query() sst.StatementNode {
+	return s.query
+}
+
+// ConflictClause returns the conflict handling of an upsert, or nil.
+func (s *InsertStatement) ConflictClause() sst.ConflictClauseNode {
+	if s.conflict == nil {
+		return nil
+	}
+	return s.conflict
+}
+
+// ReturningClause returns the RETURNING clause, or nil.
+func (s *InsertStatement) ReturningClause() sst.ReturningClauseNode {
+	if s.returning == nil {
+		return nil
+	}
+	return s.returning
+}
+
+// projectedColumns returns the number of expressions projected by a SELECT
+// or, for a set operation, by its leftmost operand, which names the result
+// columns.
+func projectedColumns(query sst.StatementNode) (int, bool) {
+	switch q := query.(type) {
+	case sst.SelectStatementNode:
+		if q.Columns() == nil {
+			return 0, true
+		}
+		return len(q.Columns().Items()), true
+	case sst.SetOperationNode:
+		return projectedColumns(q.Left())
+	}
+	return 0, false
+}
+
+// valueRow converts the values of one row into expressions, binding every
+// value that is not already an SST expression.
+func valueRow(values []any) (*sst.ExpressionList, error) {
+	if len(values) == 0 {
+		return nil, errors.New("VALUES row requires at least one value")
+	}
+
+	items := make([]sst.ExpressionNode, 0, len(values))
+	for _, value := range values {
+		expr, err := valueExpression(value)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, expr)
+	}
+	return sst.NewExpressionList(items...), nil
+}
+
+// valueExpression keeps an SST expression as it is and binds any other value
+// as a parameter.
+func valueExpression(value any) (sst.ExpressionNode, error) {
+	expr, ok := value.(sst.ExpressionNode)
+	if !ok {
+		return sst.NewBindParam(value), nil
+	}
+	if err := sst.ValidateIdentifiers(expr); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
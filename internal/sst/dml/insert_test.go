@@ -0,0 +1,240 @@
+package dml
+
+import (
+	"testing"
+
+	"github.com/candango/sqlok/internal/sst"
+	"github.com/candango/sqlok/internal/sst/dql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsertBuildsRows(t *testing.T) {
+	stmt := Insert(sst.NewTableRef("users"), "name", "active").
+		Values("ana", true).
+		Values("bia", sst.Default()).
+		Returning(sst.NewColumnRef("", "id"))
+
+	assert.NoError(t, stmt.Err())
+	assert.Equal(t, []string{"name", "active"}, stmt.Columns())
+	assert.Len(t, stmt.Rows(), 2)
+	assert.Equal(t, "ana", stmt.Rows()[0].Items()[0].(sst.BindParamNode).Value())
+	assert.IsType(t, &sst.DefaultValue{}, stmt.Rows()[1].Items()[1])
+	assert.Len(t, stmt.ReturningClause().Expressions().Items(), 1)
+}
+
+func TestInsertBindsNilValues(t *testing.T) {
+	stmt := Insert(sst.NewTableRef("users")).Values(nil)
+
+	param, ok := stmt.Rows()[0].Items()[0].(sst.BindParamNode)
+
+	assert.NoError(t, stmt.Err())
+	assert.True(t, ok)
+	assert.Nil(t, param.Value())
+	assert.Nil(t, stmt.Columns())
+	assert.Nil(t, stmt.ReturningClause())
+}
+
+func TestInsertBuildsConflictClause(t *testing.T) {
+	stmt := Insert(sst.NewTableRef("users"), "email", "name").
+		Values("ana@example.com", "ana").
+		OnConflict("email").
+		DoUpdateSet("name", sst.Excluded("name")).
+		DoUpdateSet("visits", 0)
+
+	conflict := stmt.ConflictClause()
+
+	assert.NoError(t, stmt.Err())
+	assert.Equal(t, []string{"email"}, conflict.Columns())
+	assert.Empty(t, conflict.Constraint())
+	assert.False(t, conflict.DoesNothing())
+	assert.False(t, conflict.AllowsAnyUniqueKey())
+	assert.True(t, stmt.AnyUniqueKey().ConflictClause().AllowsAnyUniqueKey())
+	assert.Len(t, conflict.Assignments(), 2)
+	assert.Equal(t, "name", conflict.Assignments()[0].Value.(*sst.ExcludedColumn).Column())
+	assert.Equal(t, 0, conflict.Assignments()[1].Value.(sst.BindParamNode).Value())
+
+	stmt = Insert(sst.NewTableRef("users")).
+		Values(1).
+		OnConflictConstraint("users_pkey").
+		DoNothing()
+
+	assert.NoError(t, stmt.Err())
+	assert.Equal(t, "users_pkey", stmt.ConflictClause().Constraint())
+	assert.Nil(t, stmt.ConflictClause().Columns())
+	assert.True(t, stmt.ConflictClause().DoesNothing())
+	assert.Nil(t, Insert(sst.NewTableRef("users")).ConflictClause())
+}
+
+func TestInsertSelectsRows(t *testing.T) {
+	users := sst.NewTableRef("users")
+	query := dql.Select(users.Column("id"), users.Column("email")).From(users)
+	stmt := Insert(sst.NewTableRef("archived_users"), "id", "email").Select(query)
+
+	assert.NoError(t, stmt.Err())
+	assert.Same(t, query, stmt.Query())
+	assert.Nil(t, stmt.Rows())
+
+	union := dql.Union(query, dql.Select(users.Column("id"), users.Column("name")).From(users))
+	stmt = Insert(sst.NewTableRef("archived_users"), "id", "email").Select(union)
+
+	assert.NoError(t, stmt.Err())
+	assert.Same(t, union, stmt.Query())
+}
+
+func TestInsertRecordsErrors(t *testing.T) {
+	users := sst.NewTableRef("users")
+	query := dql.Select(users.Column("id")).From(users)
+
+	tests := []struct {
+		name     string
+		stmt     *InsertStatement
+		expected string
+	}{
+		{
+			name:     "nil table",
+			stmt:     Insert(nil),
+			expected: "INSERT table cannot be nil",
+		},
+		{
+			name:     "invalid table",
+			stmt:     Insert(sst.NewTableRef("")),
+			expected: "invalid table name: identifier cannot be empty",
+		},
+		{
+			name:     "invalid column",
+			stmt:     Insert(sst.NewTableRef("users"), "id\x00"),
+			expected: `invalid INSERT column: identifier "id\x00" contains a NUL byte`,
+		},
+		{
+			name:     "duplicate column",
+			stmt:     Insert(sst.NewTableRef("users"), "id", "id"),
+			expected: `duplicate INSERT column "id"`,
+		},
+		{
+			name:     "empty row",
+			stmt:     Insert(sst.NewTableRef("users")).Values(),
+			expected: "VALUES row requires at least one value",
+		},
+		{
+			name:     "row shorter than columns",
+			stmt:     Insert(sst.NewTableRef("users"), "id", "name").Values(1),
+			expected: "VALUES row has 1 values for 2 columns",
+		},
+		{
+			name:     "rows of different lengths",
+			stmt:     Insert(sst.NewTableRef("users")).Values(1, "ana").Values(2),
+			expected: "VALUES rows must have the same number of values",
+		},
+		{
+			name:     "repeated ON CONFLICT",
+			stmt:     Insert(sst.NewTableRef("users")).OnConflict("id").OnConflictConstraint("users_pkey"),
+			expected: "INSERT already has an ON CONFLICT clause",
+		},
+		{
+			name:     "invalid ON CONFLICT column",
+			stmt:     Insert(sst.NewTableRef("users")).OnConflict(""),
+			expected: "invalid ON CONFLICT column: identifier cannot be empty",
+		},
+		{
+			name:     "invalid ON CONFLICT constraint",
+			stmt:     Insert(sst.NewTableRef("users")).OnConflictConstraint(""),
+			expected: "invalid ON CONFLICT constraint: identifier cannot be empty",
+		},
+		{
+			name:     "AnyUniqueKey without ON CONFLICT",
+			stmt:     Insert(sst.NewTableRef("users")).AnyUniqueKey(),
+			expected: "AnyUniqueKey requires an ON CONFLICT clause",
+		},
+		{
+			name:     "DO NOTHING without ON CONFLICT",
+			stmt:     Insert(sst.NewTableRef("users")).DoNothing(),
+			expected: "DO NOTHING requires an ON CONFLICT clause",
+		},
+		{
+			name:     "DO UPDATE without ON CONFLICT",
+			stmt:     Insert(sst.NewTableRef("users")).DoUpdateSet("name", "ana"),
+			expected: "DO UPDATE requires an ON CONFLICT clause",
+		},
+		{
+			name:     "DO UPDATE after DO NOTHING",
+			stmt:     Insert(sst.NewTableRef("users")).OnConflict("id").DoNothing().DoUpdateSet("name", "ana"),
+			expected: "ON CONFLICT cannot both DO NOTHING and DO UPDATE",
+		},
+		{
+			name:     "DO NOTHING after DO UPDATE",
+			stmt:     Insert(sst.NewTableRef("users")).OnConflict("id").DoUpdateSet("name", "ana").DoNothing(),
+			expected: "ON CONFLICT cannot both DO NOTHING and DO UPDATE",
+		},
+		{
+			name:     "duplicate DO UPDATE SET column",
+			stmt:     Insert(sst.NewTableRef("users")).OnConflict("id").DoUpdateSet("name", "ana").DoUpdateSet("name", "bia"),
+			expected: `duplicate DO UPDATE SET column "name"`,
+		},
+		{
+			name:     "invalid EXCLUDED column",
+			stmt:     Insert(sst.NewTableRef("users")).OnConflict("id").DoUpdateSet("name", sst.Excluded("")),
+			expected: "invalid EXCLUDED column: identifier cannot be empty",
+		},
+		{
+			name:     "nil SELECT query",
+			stmt:     Insert(sst.NewTableRef("users")).Select(nil),
+			expected: "INSERT SELECT query cannot be nil",
+		},
+		{
+			name:     "SELECT query that is not a query",
+			stmt:     Insert(sst.NewTableRef("users")).Select(Insert(users)),
+			expected: "INSERT SELECT query must be a SELECT or set operation",
+		},
+		{
+			name:     "SELECT query with a construction error",
+			stmt:     Insert(sst.NewTableRef("users")).Select(dql.Select(users.Column("id")).From(nil)),
+			expected: "FROM table cannot be nil",
+		},
+		{
+			name:     "SELECT query projecting other column count",
+			stmt:     Insert(sst.NewTableRef("archived_users"), "id", "email").Select(query),
+			expected: "SELECT query projects 1 columns for 2 INSERT columns",
+		},
+		{
+			name:     "repeated SELECT query",
+			stmt:     Insert(sst.NewTableRef("archived_users")).Select(query).Select(query),
+			expected: "INSERT already has a SELECT query",
+		},
+		{
+			name:     "SELECT query after VALUES",
+			stmt:     Insert(sst.NewTableRef("archived_users")).Values(1).Select(query),
+			expected: "INSERT cannot have both VALUES rows and a SELECT query",
+		},
+		{
+			name:     "VALUES after SELECT query",
+			stmt:     Insert(sst.NewTableRef("archived_users")).Select(query).Values(1),
+			expected: "INSERT cannot have both VALUES rows and a SELECT query",
+		},
+		{
+			name:     "invalid row expression",
+			stmt:     Insert(sst.NewTableRef("users")).Values(sst.NewColumnRef("", "")),
+			expected: "invalid column name: identifier cannot be empty",
+		},
+		{
+			name:     "empty returning",
+			stmt:     Insert(sst.NewTableRef("users")).Values(1).Returning(),
+			expected: "RETURNING requires at least one expression",
+		},
+		{
+			name:     "nil returning expression",
+			stmt:     Insert(sst.NewTableRef("users")).Values(1).Returning(nil),
+			expected: "RETURNING expression cannot be nil",
+		},
+		{
+			name:     "builder calls after an error",
+			stmt:     Insert(nil).Values(1).Returning(sst.NewColumnRef("", "id")),
+			expected: "INSERT table cannot be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.stmt.Err(), tt.expected)
+		})
+	}
+}
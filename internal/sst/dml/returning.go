@@ -0,0 +1,43 @@
+package dml
+
+import (
+	"errors"
+
+	"github.com/candango/sqlok/internal/sst"
+)
+
+type returningClause struct {
+	exprs *sst.ExpressionList
+}
+
+var _ sst.ReturningClauseNode = (*returningClause)(nil)
+
+func newReturningClause(exprs []sst.ExpressionNode) (*returningClause, error) {
+	if len(exprs) == 0 {
+		return nil, errors.New("RETURNING requires at least one expression")
+	}
+	for _, expr := range exprs {
+		if expr == nil {
+			return nil, errors.New("RETURNING expression cannot be nil")
+		}
+		if err := sst.ValidateIdentifiers(expr); err != nil {
+			return nil, err
+		}
+	}
+	return &returningClause{exprs: sst.NewExpressionList(exprs...)}, nil
+}
+
+func (r *returningClause) Declaration() string {
+	return "RETURNING"
+}
+
+func (r *returningClause) Accept(v sst.Visitor) error {
+	if err := v.VisitClause(r); err != nil {
+		return err
+	}
+	return r.exprs.Accept(v)
+}
+
+func (r *returningClause) Expressions() *sst.ExpressionList {
+	return r.exprs
+}
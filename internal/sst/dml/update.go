@@ -0,0 +1,198 @@
+package dml
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/candango/sqlok/internal/sst"
+)
+
+// UpdateStatement is the concrete builder and semantic root node of an
+// UPDATE statement. An UPDATE without a WHERE condition changes every row, so
+// it only compiles after an explicit AllRows opt-in.
+type UpdateStatement struct {
+	table       sst.TableRefNode
+	assignments []sst.Assignment
+	sources     []sst.TableRefNode
+	where       sst.ExpressionNode
+	allRows     bool
+	returning   *returningClause
+	err         error
+}
+
+var _ sst.UpdateStatementNode = (*UpdateStatement)(nil)
+
+// Update creates an UPDATE builder for table.
+func Update(table sst.TableRefNode) *UpdateStatement {
+	s := &UpdateStatement{}
+	if table == nil {
+		s.err = errors.New("UPDATE table cannot be nil")
+		return s
+	}
+	if err := sst.ValidateIdentifiers(table); err != nil {
+		s.err = err
+		return s
+	}
+
+	s.table = table
+	return s
+}
+
+// Accept dispatches the UPDATE statement to the provided visitor, which
+// renders the parts in the form its dialect requires.
+func (s *UpdateStatement) Accept(v sst.Visitor) error {
+	return v.VisitUpdate(s)
+}
+
+// Declaration returns the UPDATE keyword.
+func (s *UpdateStatement) Declaration() string {
+	return "UPDATE"
+}
+
+// Err returns the first construction error recorded by the statement.
+// Once an error is recorded, subsequent builder operations are no-ops.
+func (s *UpdateStatement) Err() error {
+	return s.err
+}
+
+// Set appends a column = value assignment. SST expressions, such as
+// sst.Add(counter, sst.NewBindParam(1)) or sst.Default(), are kept as they
+// are; any other value, including nil, is bound as a parameter.
+func (s *UpdateStatement) Set(column string, value any) *UpdateStatement {
+	if s.err != nil {
+		return s
+	}
+	if err := sst.ValidateIdentifier(column); err != nil {
+		s.err = fmt.Errorf("invalid SET column: %w", err)
+		return s
+	}
+	for _, assignment := range s.assignments {
+		if assignment.Column == column {
+			s.err = fmt.Errorf("duplicate SET column %q", column)
+			return s
+		}
+	}
+	expr, err := valueExpression(value)
+	if err != nil {
+		s.err = err
+		return s
+	}
+
+	s.assignments = append(s.assignments, sst.Assignment{Column: column, Value: expr})
+	return s
+}
+
+// From appends sources the assignments and WHERE condition may reference,
+// as in PostgreSQL UPDATE ... FROM. Only dialects with the UpdateFrom
+// capability compile it.
+func (s *UpdateStatement) From(tables ...sst.TableRefNode) *UpdateStatement {
+	if s.err != nil {
+		return s
+	}
+	if len(tables) == 0 {
+		s.err = errors.New("UPDATE FROM requires at least one table")
+		return s
+	}
+	for _, table := range tables {
+		if table == nil {
+			s.err = errors.New("UPDATE FROM table cannot be nil")
+			return s
+		}
+		if err := sst.ValidateIdentifiers(table); err != nil {
+			s.err = err
+			return s
+		}
+	}
+
+	s.sources = append(s.sources, tables...)
+	return s
+}
+
+// Where adds a WHERE condition. Repeated calls combine conditions with AND.
+func (s *UpdateStatement) Where(condition sst.ExpressionNode) *UpdateStatement {
+	if s.err != nil {
+		return s
+	}
+	where, err := appendCondition(s.where, condition)
+	if err != nil {
+		s.err = err
+		return s
+	}
+
+	s.where = where
+	return s
+}
+
+// AllRows opts in to updating every row when the statement has no WHERE
+// condition.
+func (s *UpdateStatement) AllRows() *UpdateStatement {
+	if s.err != nil {
+		return s
+	}
+	s.allRows = true
+	return s
+}
+
+// Returning sets the expressions returned for every updated row. Only
+// dialects with the Returning capability compile it.
+func (s *UpdateStatement) Returning(exprs ...sst.ExpressionNode) *UpdateStatement {
+	if s.err != nil {
+		return s
+	}
+	returning, err := newReturningClause(exprs)
+	if err != nil {
+		s.err = err
+		return s
+	}
+
+	s.returning = returning
+	return s
+}
+
+// Table returns the target table.
+func (s *UpdateStatement) Table() sst.TableRefNode {
+	return s.table
+}
+
+// Assignments returns the SET assignments in order.
+func (s *UpdateStatement) Assignments() []sst.Assignment {
+	return s.assignments
+}
+
+// Sources returns the additional FROM sources, or nil.
+func (s *UpdateStatement) Sources() []sst.TableRefNode {
+	return s.sources
+}
+
+// Condition returns the WHERE condition, or nil.
+func (s *UpdateStatement) Condition() sst.ExpressionNode {
+	return s.where
+}
+
+// AllowsAllRows reports whether AllRows was called.
+func (s *UpdateStatement) AllowsAllRows() bool {
+	return s.allRows
+}
+
+// ReturningClause returns the RETURNING clause, or nil.
+func (s *UpdateStatement) ReturningClause() sst.ReturningClauseNode {
+	if s.returning == nil {
+		return nil
+	}
+	return s.returning
+}
+
+// appendCondition validates condition and combines it with where, which may
+// be nil, using AND.
+func appendCondition(where, condition sst.ExpressionNode) (sst.ExpressionNode, error) {
+	if condition == nil {
+		return nil, errors.New("WHERE condition cannot be nil")
+	}
+	if err := sst.ValidateIdentifiers(condition); err != nil {
+		return nil, err
+	}
+	if where != nil {
+		return sst.And(where, condition), nil
+	}
+	return condition, nil
+}
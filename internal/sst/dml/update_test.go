@@ -0,0 +1,81 @@
+package dml
+
+import (
+	"testing"
+
+	"github.com/candango/sqlok/internal/sst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateBuildsAssignments(t *testing.T) {
+	counter := sst.NewColumnRef("", "counter")
+	stmt := Update(sst.NewTableRef("users")).
+		Set("counter", sst.Add(counter, sst.NewBindParam(1))).
+		Set("name", "ana").
+		Where(sst.Eq(sst.NewColumnRef("", "id"), sst.NewBindParam(7))).
+		Where(sst.Eq(sst.NewColumnRef("", "active"), sst.NewBindParam(true)))
+
+	assert.NoError(t, stmt.Err())
+	assert.Len(t, stmt.Assignments(), 2)
+	assert.Equal(t, "counter", stmt.Assignments()[0].Column)
+	assert.Equal(t, "ana", stmt.Assignments()[1].Value.(sst.BindParamNode).Value())
+	assert.IsType(t, &sst.LogicalExpression{}, stmt.Condition())
+	assert.False(t, stmt.AllowsAllRows())
+	assert.True(t, Update(sst.NewTableRef("users")).AllRows().AllowsAllRows())
+}
+
+func TestUpdateRecordsErrors(t *testing.T) {
+	users := sst.NewTableRef("users")
+	tests := []struct {
+		name     string
+		stmt     *UpdateStatement
+		expected string
+	}{
+		{
+			name:     "nil table",
+			stmt:     Update(nil),
+			expected: "UPDATE table cannot be nil",
+		},
+		{
+			name:     "invalid SET column",
+			stmt:     Update(users).Set("", 1),
+			expected: "invalid SET column: identifier cannot be empty",
+		},
+		{
+			name:     "duplicate SET column",
+			stmt:     Update(users).Set("name", "ana").Set("name", "bia"),
+			expected: `duplicate SET column "name"`,
+		},
+		{
+			name:     "invalid SET value",
+			stmt:     Update(users).Set("name", sst.NewColumnRef("", "")),
+			expected: "invalid column name: identifier cannot be empty",
+		},
+		{
+			name:     "empty FROM",
+			stmt:     Update(users).From(),
+			expected: "UPDATE FROM requires at least one table",
+		},
+		{
+			name:     "nil FROM table",
+			stmt:     Update(users).From(nil),
+			expected: "UPDATE FROM table cannot be nil",
+		},
+		{
+			name:     "nil WHERE condition",
+			stmt:     Update(users).Where(nil),
+			expected: "WHERE condition cannot be nil",
+		},
+		{
+			name:     "empty returning",
+			stmt:     Update(users).Returning(),
+			expected: "RETURNING requires at least one expression",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.stmt.Err(), tt.expected)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package dql
+
+import (
+	"errors"
+
+	"github.com/candango/sqlok/internal/sst"
+)
+
+// CompoundStatement is the concrete builder and semantic root node of a set
+// operation combining two queries. Chaining another set operator wraps the
+// statement as the left operand of a new compound, so chains are evaluated
+// left to right.
+type CompoundStatement struct {
+	with    *sst.WithClause
+	left    sst.StatementNode
+	op      sst.SetOperator
+	right   sst.StatementNode
+	orderBy *orderByClause
+	limit   sst.ExpressionNode
+	offset  sst.ExpressionNode
+	err     error
+}
+
+var _ sst.SetOperationNode = (*CompoundStatement)(nil)
+
+// NewCompoundStatement creates a set operation combining left and right.
+// Operands must be SELECT statements or other set operations; their
+// construction errors are recorded by the compound.
+func NewCompoundStatement(left sst.StatementNode, op sst.SetOperator, right sst.StatementNode) *CompoundStatement {
+	c := &CompoundStatement{
+		left:  left,
+		op:    op,
+		right: right,
+	}
+	c.err = validateSetOperands(op, left, right)
+	return c
+}
+
+// Union creates a left UNION right statement.
+func Union(left, right sst.StatementNode) *CompoundStatement {
+	return NewCompoundStatement(left, sst.UnionOperator, right)
+}
+
+// UnionAll creates a left UNION ALL right statement.
+func UnionAll(left, right sst.StatementNode) *CompoundStatement {
+	return NewCompoundStatement(left, sst.UnionAllOperator, right)
+}
+
+// Intersect creates a left INTERSECT right statement.
+func Intersect(left, right sst.StatementNode) *CompoundStatement {
+	return NewCompoundStatement(left, sst.IntersectOperator, right)
+}
+
+// Except creates a left EXCEPT right statement.
+func Except(left, right sst.StatementNode) *CompoundStatement {
+	return NewCompoundStatement(left, sst.ExceptOperator, right)
+}
+
+// validateSetOperands returns the first construction error of a set operation
+// and its operands.
+func validateSetOperands(op sst.SetOperator, operands ...sst.StatementNode) error {
+	switch op {
+	case sst.UnionOperator,
+		sst.UnionAllOperator,
+		sst.IntersectOperator,
+		sst.ExceptOperator:
+	default:
+		return errors.New("unsupported set operator")
+	}
+	for _, operand := range operands {
+		switch operand.(type) {
+		case sst.SelectStatementNode, sst.SetOperationNode:
+		case nil:
+			return errors.New("set operation operand cannot be nil")
+		default:
+			return errors.New("set operation operand must be a SELECT or set operation")
+		}
+		if err := operand.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Accept dispatches the set operation to the provided visitor, which renders
+// the operands with the parentheses its dialect requires.
+func (c *CompoundStatement) Accept(v sst.Visitor) error {
+	return v.VisitSetOperation(c)
+}
+
+// Declaration returns the set operator keyword.
+func (c *CompoundStatement) Declaration() string {
+	return string(c.op)
+}
+
+// Err returns the first construction error recorded by the statement.
+// Once an error is recorded, subsequent builder operations are no-ops.
+func (c *CompoundStatement) Err() error {
+	return c.err
+}
+
+// Union combines the statement with right using UNION.
+func (c *CompoundStatement) Union(right sst.StatementNode) *CompoundStatement {
+	return c.chain(sst.UnionOperator, right)
+}
+
+// UnionAll combines the statement with right using UNION ALL.
+func (c *CompoundStatement) UnionAll(right sst.StatementNode) *CompoundStatement {
+	return c.chain(sst.UnionAllOperator, right)
+}
+
+// Intersect combines the statement with right using INTERSECT.
+func (c *CompoundStatement) Intersect(right sst.StatementNode) *CompoundStatement {
+	return c.chain(sst.IntersectOperator, right)
+}
+
+// Except combines the statement with right using EXCEPT.
+func (c *CompoundStatement) Except(right sst.StatementNode) *CompoundStatement {
+	return c.chain(sst.ExceptOperator, right)
+}
+
+func (c *CompoundStatement) chain(op sst.SetOperator, right sst.StatementNode) *CompoundStatement {
+	if c.err != nil {
+		return c
+	}
+	return NewCompoundStatement(c, op, right)
+}
+
+// With declares common table expressions ahead of the compound statement.
+// Repeated calls append to the same WITH clause.
+func (c *CompoundStatement) With(tables ...sst.CommonTableExpressionNode) *CompoundStatement {
+	if c.err != nil {
+		return c
+	}
+	with, err := appendWith(c.with, tables)
+	if err != nil {
+		c.err = err
+		return c
+	}
+
+	c.with = with
+	return c
+}
+
+// OrderBy appends ORDER BY terms applied to the compound result. Terms
+// usually reference output columns by name, as in sst.NewColumnRef("", "id").
+func (c *CompoundStatement) OrderBy(terms ...sst.ExpressionNode) *CompoundStatement {
+	if c.err != nil {
+		return c
+	}
+	orderBy, err := appendOrdering(c.orderBy, terms)
+	if err != nil {
+		c.err = err
+		return c
+	}
+
+	c.orderBy = orderBy
+	return c
+}
+
+// Limit sets the maximum number of returned rows as a bind parameter.
+func (c *CompoundStatement) Limit(limit int) *CompoundStatement {
+	if c.err != nil {
+		return c
+	}
+	param, err := rowCount("LIMIT", limit)
+	if err != nil {
+		c.err = err
+		return c
+	}
+
+	c.limit = param
+	return c
+}
+
+// Offset sets the number of skipped rows as a bind parameter.
+func (c *CompoundStatement) Offset(offset int) *CompoundStatement {
+	if c.err != nil {
+		return c
+	}
+	param, err := rowCount("OFFSET", offset)
+	if err != nil {
+		c.err = err
+		return c
+	}
+
+	c.offset = param
+	return c
+}
+
+// WithClause returns the WITH clause declared ahead of the compound, or nil.
+func (c *CompoundStatement) WithClause() sst.WithClauseNode {
+	if c.with == nil {
+		return nil
+	}
+	return c.with
+}
+
+// Left returns the left query.
+func (c *CompoundStatement) Left() sst.StatementNode {
+	return c.left
+}
+
+// Operator returns the set operator.
+func (c *CompoundStatement) Operator() sst.SetOperator {
+	return c.op
+}
+
+// Right returns the right query.
+func (c *CompoundStatement) Right() sst.StatementNode {
+	return c.right
+}
+
+// Ordering returns the ORDER BY terms of the compound result, or nil.
+func (c *CompoundStatement) Ordering() *sst.ExpressionList {
+	if c.orderBy == nil {
+		return nil
+	}
+	return c.orderBy.terms
+}
+
+// LimitClause returns the row-limiting clause of the compound result, or nil.
+func (c *CompoundStatement) LimitClause() sst.LimitClauseNode {
+	if c.limit == nil && c.offset == nil {
+		return nil
+	}
+	return newLimitClause(c.limit, c.offset, c.orderBy != nil)
+}
@@ -0,0 +1,76 @@
+package dql
+
+import (
+	"testing"
+
+	"github.com/candango/sqlok/internal/sst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompoundChainsLeftDeep(t *testing.T) {
+	first := Select(sst.NewColumnRef("a", "id"))
+	second := Select(sst.NewColumnRef("b", "id"))
+	third := Select(sst.NewColumnRef("c", "id"))
+
+	stmt := Union(first, second).Intersect(third)
+
+	assert.NoError(t, stmt.Err())
+	assert.Equal(t, sst.IntersectOperator, stmt.Operator())
+	assert.Same(t, third, stmt.Right())
+
+	left, ok := stmt.Left().(*CompoundStatement)
+
+	assert.True(t, ok)
+	assert.Equal(t, sst.UnionOperator, left.Operator())
+	assert.Same(t, first, left.Left())
+	assert.Same(t, second, left.Right())
+}
+
+func TestCompoundTraversal(t *testing.T) {
+	visitor := &traversingVisitor{}
+	stmt := UnionAll(
+		Select(sst.NewColumnRef("a", "id")).Where(sst.Eq(sst.NewColumnRef("a", "id"), sst.NewBindParam(1))),
+		Select(sst.NewColumnRef("b", "id")),
+	).OrderBy(sst.NewColumnRef("", "id")).Limit(5)
+
+	err := stmt.Accept(visitor)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"left", "UNION ALL", "right"}, visitor.setEvents)
+	assert.Equal(t, 4, visitor.visitedColumnRefs)
+	assert.Equal(t, []any{1, 5}, visitor.bindParams)
+}
+
+func TestCompoundRecordsErrors(t *testing.T) {
+	t.Run("should record operand construction errors", func(t *testing.T) {
+		stmt := Union(Select(), Select().From(nil))
+
+		assert.EqualError(t, stmt.Err(), "FROM table cannot be nil")
+	})
+
+	t.Run("should reject unsupported operands", func(t *testing.T) {
+		stmt := Except(Select(), &fakeStatement{})
+
+		assert.EqualError(t, stmt.Err(), "set operation operand must be a SELECT or set operation")
+	})
+
+	t.Run("should keep the first error through chained calls", func(t *testing.T) {
+		stmt := Union(Select(), nil).Union(Select()).OrderBy().Limit(-1)
+
+		assert.EqualError(t, stmt.Err(), "set operation operand cannot be nil")
+	})
+}
+
+type fakeStatement struct{}
+
+func (s *fakeStatement) Accept(v sst.Visitor) error {
+	return v.VisitStatement(s)
+}
+
+func (s *fakeStatement) Declaration() string {
+	return "FAKE"
+}
+
+func (s *fakeStatement) Err() error {
+	return nil
+}
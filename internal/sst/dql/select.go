@@ -11,11 +11,18 @@ import (
 // SELECT statement. It implements sst.SelectBuilder for construction and
 // sst.SelectStatementNode for traversal and compilation.
 type SelectStatement struct {
+	with        *sst.WithClause
+	distinct    *distinctClause
 	columns     *sst.ExpressionList
 	source      sst.FromSourceNode
 	tailSource  sst.FromSourceNode
 	pendingJoin *Join
 	where       *whereClause
+	groupBy     *groupByClause
+	having      *havingClause
+	orderBy     *orderByClause
+	limit       sst.ExpressionNode
+	offset      sst.ExpressionNode
 	err         error
 }
 
@@ -27,15 +34,32 @@ func Select(columns ...sst.ExpressionNode) *SelectStatement {
 	s := &SelectStatement{}
 	if len(columns) > 0 {
 		s.columns = sst.NewExpressionList(columns...)
+		s.err = sst.ValidateIdentifiers(s.columns)
 	}
 	return s
 }
 
 // Accept dispatches the SELECT node to the provided visitor.
 func (s *SelectStatement) Accept(v sst.Visitor) error {
+	if s.with != nil {
+		if err := s.with.Accept(v); err != nil {
+			return err
+		}
+	}
 	if err := v.VisitStatement(s); err != nil {
 		return err
 	}
+	if distinct := s.DistinctClause(); distinct != nil {
+		if err := v.VisitDistinct(distinct); err != nil {
+			return err
+		}
+	}
+	limit := s.LimitClause()
+	if limit != nil {
+		if err := v.VisitLimit(limit, sst.LimitAfterDeclaration); err != nil {
+			return err
+		}
+	}
 	if s.columns != nil {
 		if err := s.columns.Accept(v); err != nil {
 			return err
@@ -59,6 +83,36 @@ func (s *SelectStatement) Accept(v sst.Visitor) error {
 			return err
 		}
 	}
+	if s.groupBy != nil {
+		if err := v.VisitClause(s.groupBy); err != nil {
+			return err
+		}
+
+		if err := s.groupBy.Accept(v); err != nil {
+			return err
+		}
+	}
+	if s.having != nil {
+		if err := v.VisitClause(s.having); err != nil {
+			return err
+		}
+
+		if err := s.having.Accept(v); err != nil {
+			return err
+		}
+	}
+	if s.orderBy != nil {
+		if err := v.VisitClause(s.orderBy); err != nil {
+			return err
+		}
+
+		if err := s.orderBy.Accept(v); err != nil {
+			return err
+		}
+	}
+	if limit != nil {
+		return v.VisitLimit(limit, sst.LimitAfterOrdering)
+	}
 	return nil
 }
 
@@ -78,6 +132,84 @@ func (s *SelectStatement) Columns() *sst.ExpressionList {
 	return s.columns
 }
 
+// With declares common table expressions ahead of the SELECT statement.
+// Repeated calls append to the same WITH clause.
+func (s *SelectStatement) With(tables ...sst.CommonTableExpressionNode) sst.SelectBuilder {
+	if s.err != nil {
+		return s
+	}
+	with, err := appendWith(s.with, tables)
+	if err != nil {
+		s.err = err
+		return s
+	}
+
+	s.with = with
+	return s
+}
+
+// WithClause returns the WITH clause, or nil when the statement declares no
+// common table expressions.
+func (s *SelectStatement) WithClause() sst.WithClauseNode {
+	if s.with == nil {
+		return nil
+	}
+	return s.with
+}
+
+// Distinct removes duplicate rows from the result. It does not replace
+// expressions already set by DistinctOn.
+func (s *SelectStatement) Distinct() sst.SelectBuilder {
+	if s.err != nil {
+		return s
+	}
+	if s.distinct == nil {
+		s.distinct = &distinctClause{}
+	}
+	return s
+}
+
+// DistinctOn appends DISTINCT ON expressions, keeping the first row of each
+// group of rows with equal expressions. Only dialects with the DistinctOn
+// capability compile it, and ORDER BY must start with the same expressions.
+func (s *SelectStatement) DistinctOn(exprs ...sst.ExpressionNode) sst.SelectBuilder {
+	if s.err != nil {
+		return s
+	}
+	if len(exprs) == 0 {
+		s.err = errors.New("DISTINCT ON requires at least one expression")
+		return s
+	}
+
+	items := make([]sst.ExpressionNode, 0, len(exprs))
+	if s.distinct != nil && s.distinct.on != nil {
+		items = append(items, s.distinct.on.Items()...)
+	}
+	for _, expr := range exprs {
+		if expr == nil {
+			s.err = errors.New("DISTINCT ON expression cannot be nil")
+			return s
+		}
+		if err := sst.ValidateIdentifiers(expr); err != nil {
+			s.err = err
+			return s
+		}
+		items = append(items, expr)
+	}
+
+	s.distinct = &distinctClause{on: sst.NewExpressionList(items...)}
+	return s
+}
+
+// DistinctClause returns the DISTINCT modifier, or nil when the statement
+// keeps duplicate rows.
+func (s *SelectStatement) DistinctClause() sst.DistinctClauseNode {
+	if s.distinct == nil {
+		return nil
+	}
+	return &distinctClause{on: s.distinct.on, ordering: s.Ordering()}
+}
+
 // From sets the primary FROM source and returns the SELECT statement.
 func (s *SelectStatement) From(table sst.TableRefNode) sst.SelectBuilder {
 	if s.err != nil {
@@ -87,6 +219,10 @@ func (s *SelectStatement) From(table sst.TableRefNode) sst.SelectBuilder {
 		s.err = errors.New("FROM table cannot be nil")
 		return s
 	}
+	if err := sst.ValidateIdentifiers(table); err != nil {
+		s.err = err
+		return s
+	}
 
 	s.source = NewFromSource(table)
 	s.tailSource = s.source
@@ -153,6 +289,32 @@ func (s *SelectStatement) RightJoin(table sst.TableRefNode) sst.SelectBuilder {
 	return s
 }
 
+// FullOuterJoin adds a source with the FULL OUTER JOIN type. Dialects without
+// FULL OUTER JOIN reject the statement at compile time.
+func (s *SelectStatement) FullOuterJoin(table sst.TableRefNode) sst.SelectBuilder {
+	if s.err != nil {
+		return s
+	}
+	if err := s.addJoin(table, sst.FullOuterJoin); err != nil {
+		s.err = err
+		return s
+	}
+	return s
+}
+
+// NaturalJoin adds a source with the NATURAL JOIN type, which matches the
+// columns both sources share by name and takes no condition.
+func (s *SelectStatement) NaturalJoin(table sst.TableRefNode) sst.SelectBuilder {
+	if s.err != nil {
+		return s
+	}
+	if err := s.addJoin(table, sst.NaturalJoin); err != nil {
+		s.err = err
+		return s
+	}
+	return s
+}
+
 // addJoin appends a source with the requested SQL join type, attaches the
 // resulting join to the current source, advances the forward chain, and marks
 // the join as pending for a subsequent On condition.
@@ -163,6 +325,9 @@ func (s *SelectStatement) addJoin(table sst.TableRefNode, jtype sst.JoinType) er
 	if table == nil {
 		return fmt.Errorf("%s table cannot be nil", jtype)
 	}
+	if err := sst.ValidateIdentifiers(table); err != nil {
+		return err
+	}
 	source := NewFromSource(table)
 	j := NewJoin(s.tailSource, source, WithJoinType(jtype))
 	if err := s.tailSource.Attach(j); err != nil {
@@ -182,6 +347,10 @@ func (s *SelectStatement) Where(condition sst.ExpressionNode) sst.SelectBuilder
 		s.err = errors.New("WHERE condition cannot be nil")
 		return s
 	}
+	if err := sst.ValidateIdentifiers(condition); err != nil {
+		s.err = err
+		return s
+	}
 	if s.where != nil {
 		condition = sst.And(s.where.condition, condition)
 	}
@@ -199,21 +368,183 @@ func (s *SelectStatement) On(condition sst.Node) sst.SelectBuilder {
 		s.err = errors.New("ON requires a pending JOIN")
 		return s
 	}
+	if !s.pendingJoin.Type().Conditional() {
+		s.err = fmt.Errorf("%s cannot have an ON condition", s.pendingJoin.Type())
+		return s
+	}
 	if condition == nil {
 		s.err = errors.New("JOIN condition cannot be nil")
 		return s
 	}
+	if err := sst.ValidateIdentifiers(condition); err != nil {
+		s.err = err
+		return s
+	}
 
 	s.pendingJoin.SetOn(condition)
 	s.pendingJoin = nil
 	return s
 }
 
+// Using completes the most recently created JOIN with the columns both
+// sources share, as in USING (id).
+func (s *SelectStatement) Using(columns ...string) sst.SelectBuilder {
+	if s.err != nil {
+		return s
+	}
+	if s.pendingJoin == nil {
+		s.err = errors.New("USING requires a pending JOIN")
+		return s
+	}
+	if !s.pendingJoin.Type().Conditional() {
+		s.err = fmt.Errorf("%s cannot have a USING condition", s.pendingJoin.Type())
+		return s
+	}
+	if len(columns) == 0 {
+		s.err = errors.New("USING requires at least one column")
+		return s
+	}
+	for _, column := range columns {
+		if err := sst.ValidateIdentifier(column); err != nil {
+			s.err = fmt.Errorf("invalid USING column: %w", err)
+			return s
+		}
+	}
+
+	s.pendingJoin.SetUsing(append([]string(nil), columns...))
+	s.pendingJoin = nil
+	return s
+}
+
 // Source returns the primary FROM source.
 func (s *SelectStatement) Source() sst.FromSourceNode {
 	return s.source
 }
 
+// GroupBy appends GROUP BY expressions.
+func (s *SelectStatement) GroupBy(exprs ...sst.ExpressionNode) sst.SelectBuilder {
+	if s.err != nil {
+		return s
+	}
+	if len(exprs) == 0 {
+		s.err = errors.New("GROUP BY requires at least one expression")
+		return s
+	}
+
+	items := make([]sst.ExpressionNode, 0, len(exprs))
+	if s.groupBy != nil {
+		items = append(items, s.groupBy.exprs.Items()...)
+	}
+	for _, expr := range exprs {
+		if expr == nil {
+			s.err = errors.New("GROUP BY expression cannot be nil")
+			return s
+		}
+		if err := sst.ValidateIdentifiers(expr); err != nil {
+			s.err = err
+			return s
+		}
+		items = append(items, expr)
+	}
+
+	s.groupBy = newGroupByClause(sst.NewExpressionList(items...))
+	return s
+}
+
+// Having adds a HAVING clause with the provided condition. Repeated calls
+// combine their conditions with AND.
+func (s *SelectStatement) Having(condition sst.ExpressionNode) sst.SelectBuilder {
+	if s.err != nil {
+		return s
+	}
+	if condition == nil {
+		s.err = errors.New("HAVING condition cannot be nil")
+		return s
+	}
+	if err := sst.ValidateIdentifiers(condition); err != nil {
+		s.err = err
+		return s
+	}
+	if s.having != nil {
+		condition = sst.And(s.having.condition, condition)
+	}
+
+	s.having = newHavingClause(condition)
+	return s
+}
+
+// OrderBy appends ORDER BY terms. Expressions that are not ordering terms are
+// sorted in the default direction.
+func (s *SelectStatement) OrderBy(terms ...sst.ExpressionNode) sst.SelectBuilder {
+	if s.err != nil {
+		return s
+	}
+	orderBy, err := appendOrdering(s.orderBy, terms)
+	if err != nil {
+		s.err = err
+		return s
+	}
+
+	s.orderBy = orderBy
+	return s
+}
+
+// Limit sets the maximum number of returned rows as a bind parameter.
+func (s *SelectStatement) Limit(limit int) sst.SelectBuilder {
+	if s.err != nil {
+		return s
+	}
+	param, err := rowCount("LIMIT", limit)
+	if err != nil {
+		s.err = err
+		return s
+	}
+
+	s.limit = param
+	return s
+}
+
+// Offset sets the number of skipped rows as a bind parameter.
+func (s *SelectStatement) Offset(offset int) sst.SelectBuilder {
+	if s.err != nil {
+		return s
+	}
+	param, err := rowCount("OFFSET", offset)
+	if err != nil {
+		s.err = err
+		return s
+	}
+
+	s.offset = param
+	return s
+}
+
+// Condition returns the WHERE condition, or nil.
+func (s *SelectStatement) Condition() sst.ExpressionNode {
+	if s.where == nil {
+		return nil
+	}
+	return s.where.condition
+}
+
+// Ordering returns the ORDER BY terms, or nil when the statement is not
+// ordered.
+func (s *SelectStatement) Ordering() *sst.ExpressionList {
+	if s.orderBy == nil {
+		return nil
+	}
+	return s.orderBy.terms
+}
+
+// LimitClause returns the row-limiting clause, or nil when the statement has
+// neither LIMIT nor OFFSET.
+func (s *SelectStatement) LimitClause() sst.LimitClauseNode {
+	if s.limit == nil && s.offset == nil {
+		return nil
+	}
+	return newLimitClause(s.limit, s.offset, s.orderBy != nil)
+}
+
 type whereClause struct {
 	condition sst.ExpressionNode
 }
@@ -232,6 +563,172 @@ func (w *whereClause) Accept(v sst.Visitor) error {
 	return w.condition.Accept(v)
 }
 
+type groupByClause struct {
+	exprs *sst.ExpressionList
+}
+
+var _ sst.ClauseNode = (*groupByClause)(nil)
+
+func newGroupByClause(exprs *sst.ExpressionList) *groupByClause {
+	return &groupByClause{exprs: exprs}
+}
+
+func (g *groupByClause) Declaration() string {
+	return "GROUP BY"
+}
+
+func (g *groupByClause) Accept(v sst.Visitor) error {
+	return g.exprs.Accept(v)
+}
+
+type havingClause struct {
+	condition sst.ExpressionNode
+}
+
+var _ sst.ClauseNode = (*havingClause)(nil)
+
+func newHavingClause(condition sst.ExpressionNode) *havingClause {
+	return &havingClause{condition: condition}
+}
+
+func (h *havingClause) Declaration() string {
+	return "HAVING"
+}
+
+func (h *havingClause) Accept(v sst.Visitor) error {
+	return h.condition.Accept(v)
+}
+
+// appendOrdering returns an ORDER BY clause with terms appended to the terms
+// of orderBy, which may be nil. Expressions that are not ordering terms are
+// sorted in the default direction.
+func appendOrdering(orderBy *orderByClause, terms []sst.ExpressionNode) (*orderByClause, error) {
+	if len(terms) == 0 {
+		return nil, errors.New("ORDER BY requires at least one expression")
+	}
+
+	items := make([]sst.ExpressionNode, 0, len(terms))
+	if orderBy != nil {
+		items = append(items, orderBy.terms.Items()...)
+	}
+	for _, term := range terms {
+		if term == nil {
+			return nil, errors.New("ORDER BY expression cannot be nil")
+		}
+		if err := sst.ValidateIdentifiers(term); err != nil {
+			return nil, err
+		}
+		if _, ok := term.(sst.OrderingNode); !ok {
+			term = sst.NewOrderingTerm(term, sst.DefaultDirection)
+		}
+		items = append(items, term)
+	}
+
+	return newOrderByClause(sst.NewExpressionList(items...)), nil
+}
+
+// appendWith returns a WITH clause declaring tables after the tables of with,
+// which may be nil.
+func appendWith(with *sst.WithClause, tables []sst.CommonTableExpressionNode) (*sst.WithClause, error) {
+	if len(tables) == 0 {
+		return nil, errors.New("WITH requires at least one common table expression")
+	}
+	if with != nil {
+		tables = append(append([]sst.CommonTableExpressionNode(nil), with.Tables()...), tables...)
+	}
+
+	clause := sst.NewWithClause(tables...)
+	if err := sst.ValidateIdentifiers(clause); err != nil {
+		return nil, err
+	}
+	return clause, nil
+}
+
+// rowCount returns a LIMIT or OFFSET row count as a bind parameter.
+func rowCount(clause string, n int) (sst.ExpressionNode, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("%s cannot be negative", clause)
+	}
+	return sst.NewBindParam(n), nil
+}
+
+type orderByClause struct {
+	terms *sst.ExpressionList
+}
+
+var _ sst.ClauseNode = (*orderByClause)(nil)
+
+func newOrderByClause(terms *sst.ExpressionList) *orderByClause {
+	return &orderByClause{terms: terms}
+}
+
+func (o *orderByClause) Declaration() string {
+	return "ORDER BY"
+}
+
+func (o *orderByClause) Accept(v sst.Visitor) error {
+	return o.terms.Accept(v)
+}
+
+type distinctClause struct {
+	on       *sst.ExpressionList
+	ordering *sst.ExpressionList
+}
+
+var _ sst.DistinctClauseNode = (*distinctClause)(nil)
+
+func (d *distinctClause) Declaration() string {
+	return "DISTINCT"
+}
+
+func (d *distinctClause) Accept(v sst.Visitor) error {
+	return v.VisitDistinct(d)
+}
+
+func (d *distinctClause) On() *sst.ExpressionList {
+	return d.on
+}
+
+func (d *distinctClause) Ordering() *sst.ExpressionList {
+	return d.ordering
+}
+
+type limitClause struct {
+	limit   sst.ExpressionNode
+	offset  sst.ExpressionNode
+	ordered bool
+}
+
+var _ sst.LimitClauseNode = (*limitClause)(nil)
+
+func newLimitClause(limit, offset sst.ExpressionNode, ordered bool) *limitClause {
+	return &limitClause{
+		limit:   limit,
+		offset:  offset,
+		ordered: ordered,
+	}
+}
+
+func (l *limitClause) Declaration() string {
+	return "LIMIT"
+}
+
+func (l *limitClause) Accept(v sst.Visitor) error {
+	return v.VisitLimit(l, sst.LimitAfterOrdering)
+}
+
+func (l *limitClause) Limit() sst.ExpressionNode {
+	return l.limit
+}
+
+func (l *limitClause) Offset() sst.ExpressionNode {
+	return l.offset
+}
+
+func (l *limitClause) Ordered() bool {
+	return l.ordered
+}
+
 // FromSource represents a SELECT source table and its next attached join.
 type FromSource struct {
 	table sst.TableRefNode
@@ -294,13 +791,14 @@ func (fs *FromSource) Accept(v sst.Visitor) error {
 }
 
 // Join represents one relationship between a left source and a right source.
-// Its On condition may be nil until the builder or dialect validation resolves
-// the incomplete join.
+// Its On condition or Using columns may be nil until the builder or dialect
+// validation resolves the incomplete join.
 type Join struct {
 	left  sst.FromSourceNode
 	right sst.FromSourceNode
 	jtype sst.JoinType
 	on    sst.Node
+	using []string
 }
 
 var _ sst.JoinNode = (*Join)(nil)
@@ -358,6 +856,17 @@ func (j *Join) SetOn(condition sst.Node) {
 	j.on = condition
 }
 
+// Using returns the shared columns of a USING condition.
+func (j *Join) Using() []string {
+	return j.using
+}
+
+// SetUsing attaches the USING columns while the statement builder completes
+// the pending join.
+func (j *Join) SetUsing(columns []string) {
+	j.using = columns
+}
+
 // Accept dispatches the join to the provided visitor. Visitors should follow
 // Right for forward traversal and treat Left as a back-reference.
 func (j *Join) Accept(v sst.Visitor) error {
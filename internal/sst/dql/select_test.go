@@ -29,6 +29,26 @@ func (v *fakeVisitor) VisitColumnRef(s sst.ColumnRefNode) error {
 	return nil
 }
 
+func (v *fakeVisitor) VisitCast(expr sst.CastExpressionNode) error {
+	return nil
+}
+
+func (v *fakeVisitor) VisitConcat(expr sst.ConcatExpressionNode) error {
+	return nil
+}
+
+func (v *fakeVisitor) VisitDerivedTable(d sst.DerivedTableNode) error {
+	return nil
+}
+
+func (v *fakeVisitor) VisitDistinct(d sst.DistinctClauseNode) error {
+	return nil
+}
+
+func (v *fakeVisitor) VisitDistinctFrom(expr sst.DistinctFromExpressionNode) error {
+	return nil
+}
+
 func (v *fakeVisitor) VisitExpression(expr sst.ExpressionNode) error {
 	return nil
 }
@@ -45,6 +65,26 @@ func (v *fakeVisitor) VisitFromSource(s sst.FromSourceNode) error {
 	return nil
 }
 
+func (v *fakeVisitor) VisitPattern(expr sst.PatternExpressionNode) error {
+	return nil
+}
+
+func (v *fakeVisitor) VisitOrdering(o sst.OrderingNode) error {
+	return nil
+}
+
+func (v *fakeVisitor) VisitLimit(l sst.LimitClauseNode, position sst.LimitPosition) error {
+	return nil
+}
+
+func (v *fakeVisitor) VisitSetOperation(stmt sst.SetOperationNode) error {
+	return nil
+}
+
+func (v *fakeVisitor) VisitWith(w sst.WithClauseNode) error {
+	return nil
+}
+
 func (v *fakeVisitor) VisitListSeparator(index int) error {
 	return nil
 }
@@ -53,6 +93,18 @@ func (v *fakeVisitor) VisitTableRef(s sst.TableRefNode) error {
 	return nil
 }
 
+func (v *fakeVisitor) VisitInsert(s sst.InsertStatementNode) error {
+	return nil
+}
+
+func (v *fakeVisitor) VisitUpdate(s sst.UpdateStatementNode) error {
+	return nil
+}
+
+func (v *fakeVisitor) VisitDelete(s sst.DeleteStatementNode) error {
+	return nil
+}
+
 func (v *fakeVisitor) VisitJoin(s sst.JoinNode) error {
 	return nil
 }
@@ -76,6 +128,14 @@ type traversingVisitor struct {
 	visitedNotExpressions    int
 	bindParams               []any
 	visitedLiterals          int
+	visitedGroupBy           bool
+	visitedHaving            bool
+	visitedOrderBy           bool
+	orderingEvents           []string
+	limitPositions           []sst.LimitPosition
+	setEvents                []string
+	withEvents               []string
+	visitedDistinct          bool
 }
 
 func (v *traversingVisitor) VisitStatement(s sst.StatementNode) error {
@@ -92,8 +152,15 @@ func (v *traversingVisitor) VisitColumnRef(s sst.ColumnRefNode) error {
 }
 
 func (v *traversingVisitor) VisitClause(s sst.ClauseNode) error {
-	if s.Declaration() == "WHERE" {
+	switch s.Declaration() {
+	case "WHERE":
 		v.visitedWhere = true
+	case "GROUP BY":
+		v.visitedGroupBy = true
+	case "HAVING":
+		v.visitedHaving = true
+	case "ORDER BY":
+		v.visitedOrderBy = true
 	}
 	return nil
 }
@@ -118,6 +185,42 @@ func (v *traversingVisitor) VisitExpression(expr sst.ExpressionNode) error {
 	return nil
 }
 
+func (v *traversingVisitor) VisitCast(expr sst.CastExpressionNode) error {
+	return expr.Operand().Accept(v)
+}
+
+func (v *traversingVisitor) VisitConcat(expr sst.ConcatExpressionNode) error {
+	for _, operand := range expr.Operands() {
+		if err := operand.Accept(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *traversingVisitor) VisitDerivedTable(d sst.DerivedTableNode) error {
+	v.joinEvents = append(v.joinEvents, "derived:"+d.Alias())
+	return d.Query().Accept(v)
+}
+
+func (v *traversingVisitor) VisitDistinct(d sst.DistinctClauseNode) error {
+	v.visitedDistinct = true
+	if on := d.On(); on != nil {
+		return on.Accept(v)
+	}
+	return nil
+}
+
+func (v *traversingVisitor) VisitDistinctFrom(expr sst.DistinctFromExpressionNode) error {
+	if err := expr.Left().Accept(v); err != nil {
+		return err
+	}
+	if err := v.VisitExpression(expr); err != nil {
+		return err
+	}
+	return expr.Right().Accept(v)
+}
+
 func (v *traversingVisitor) VisitExpressionGroupStart() error {
 	return nil
 }
@@ -126,6 +229,71 @@ func (v *traversingVisitor) VisitExpressionGroupEnd() error {
 	return nil
 }
 
+func (v *traversingVisitor) VisitPattern(expr sst.PatternExpressionNode) error {
+	if err := expr.Subject().Accept(v); err != nil {
+		return err
+	}
+	if err := v.VisitExpression(expr); err != nil {
+		return err
+	}
+	return expr.Pattern().Accept(v)
+}
+
+func (v *traversingVisitor) VisitOrdering(o sst.OrderingNode) error {
+	if err := o.Expression().Accept(v); err != nil {
+		return err
+	}
+	v.orderingEvents = append(v.orderingEvents, o.Expression().Expr()+o.Expr())
+	return nil
+}
+
+func (v *traversingVisitor) VisitLimit(l sst.LimitClauseNode, position sst.LimitPosition) error {
+	v.limitPositions = append(v.limitPositions, position)
+	if position != sst.LimitAfterOrdering {
+		return nil
+	}
+	if limit := l.Limit(); limit != nil {
+		if err := limit.Accept(v); err != nil {
+			return err
+		}
+	}
+	if offset := l.Offset(); offset != nil {
+		return offset.Accept(v)
+	}
+	return nil
+}
+
+func (v *traversingVisitor) VisitSetOperation(stmt sst.SetOperationNode) error {
+	v.setEvents = append(v.setEvents, "left")
+	if err := stmt.Left().Accept(v); err != nil {
+		return err
+	}
+	v.setEvents = append(v.setEvents, stmt.Declaration())
+	if err := stmt.Right().Accept(v); err != nil {
+		return err
+	}
+	v.setEvents = append(v.setEvents, "right")
+	if ordering := stmt.Ordering(); ordering != nil {
+		if err := ordering.Accept(v); err != nil {
+			return err
+		}
+	}
+	if limit := stmt.LimitClause(); limit != nil {
+		return v.VisitLimit(limit, sst.LimitAfterOrdering)
+	}
+	return nil
+}
+
+func (v *traversingVisitor) VisitWith(w sst.WithClauseNode) error {
+	for _, cte := range w.Tables() {
+		v.withEvents = append(v.withEvents, cte.Name())
+		if err := cte.Query().Accept(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (v *traversingVisitor) VisitListSeparator(index int) error {
 	return nil
 }
@@ -149,6 +317,18 @@ func (v *traversingVisitor) VisitTableRef(s sst.TableRefNode) error {
 	return nil
 }
 
+func (v *traversingVisitor) VisitInsert(s sst.InsertStatementNode) error {
+	return nil
+}
+
+func (v *traversingVisitor) VisitUpdate(s sst.UpdateStatementNode) error {
+	return nil
+}
+
+func (v *traversingVisitor) VisitDelete(s sst.DeleteStatementNode) error {
+	return nil
+}
+
 func (v *traversingVisitor) VisitJoin(j sst.JoinNode) error {
 	v.visitedJoin = true
 	v.joinEvents = append(v.joinEvents, "join")
@@ -373,3 +553,191 @@ func TestSelectJoinTraversalChain(t *testing.T) {
 		}, visitor.joinEvents)
 	})
 }
+
+func TestSelectGroupByTraversal(t *testing.T) {
+	visitor := &traversingVisitor{}
+	stmt := Select(
+		sst.NewColumnRef("orders", "user_id"),
+		sst.CountAll(),
+	).From(
+		sst.NewTableRef("orders"),
+	).GroupBy(
+		sst.NewColumnRef("orders", "user_id"),
+	).Having(
+		sst.Gt(sst.CountAll(), sst.NewBindParam(5)),
+	)
+
+	err := stmt.Accept(visitor)
+
+	assert.NoError(t, err)
+	assert.True(t, visitor.visitedGroupBy)
+	assert.True(t, visitor.visitedHaving)
+	assert.Equal(t, 2, visitor.visitedColumnRefs)
+	assert.Equal(t, 1, visitor.visitedBinaryExpressions)
+	assert.Equal(t, []any{5}, visitor.bindParams)
+}
+
+func TestSelectDistinctTraversal(t *testing.T) {
+	t.Run("should traverse DISTINCT ON expressions", func(t *testing.T) {
+		visitor := &traversingVisitor{}
+		stmt := Select(sst.NewColumnRef("users", "id")).
+			DistinctOn(sst.NewColumnRef("users", "team_id")).
+			DistinctOn(sst.NewColumnRef("users", "role")).
+			Distinct().
+			From(sst.NewTableRef("users")).
+			OrderBy(sst.NewColumnRef("users", "team_id"))
+
+		err := stmt.Accept(visitor)
+
+		assert.NoError(t, err)
+		assert.True(t, visitor.visitedDistinct)
+		assert.Equal(t, 4, visitor.visitedColumnRefs)
+		assert.Len(t, stmt.DistinctClause().On().Items(), 2)
+		assert.Len(t, stmt.DistinctClause().Ordering().Items(), 1)
+	})
+
+	t.Run("should omit DISTINCT by default", func(t *testing.T) {
+		assert.Nil(t, Select().DistinctClause())
+		assert.Nil(t, Select().Distinct().DistinctClause().On())
+	})
+
+	t.Run("should reject empty and nil DISTINCT ON expressions", func(t *testing.T) {
+		assert.EqualError(t, Select().DistinctOn().Err(), "DISTINCT ON requires at least one expression")
+		assert.EqualError(t, Select().DistinctOn(nil).Err(), "DISTINCT ON expression cannot be nil")
+	})
+}
+
+func TestSelectOrderByTraversal(t *testing.T) {
+	t.Run("should traverse ordering terms and the limit clause", func(t *testing.T) {
+		visitor := &traversingVisitor{}
+		stmt := Select(sst.NewColumnRef("users", "id")).
+			From(sst.NewTableRef("users")).
+			OrderBy(sst.NewColumnRef("users", "name")).
+			OrderBy(sst.Desc(sst.NewColumnRef("users", "id"))).
+			Limit(10).
+			Offset(20)
+
+		assert.Len(t, stmt.Ordering().Items(), 2)
+		assert.True(t, stmt.LimitClause().Ordered())
+
+		err := stmt.Accept(visitor)
+
+		assert.NoError(t, err)
+		assert.True(t, visitor.visitedOrderBy)
+		assert.Equal(t, []string{"users.name", "users.id DESC"}, visitor.orderingEvents)
+		assert.Equal(t, []sst.LimitPosition{
+			sst.LimitAfterDeclaration,
+			sst.LimitAfterOrdering,
+		}, visitor.limitPositions)
+		assert.Equal(t, []any{10, 20}, visitor.bindParams)
+	})
+
+	t.Run("should omit the limit clause when unbounded", func(t *testing.T) {
+		stmt := Select().From(sst.NewTableRef("users"))
+
+		assert.Nil(t, stmt.Ordering())
+		assert.Nil(t, stmt.LimitClause())
+	})
+
+	t.Run("should reject negative limits and offsets", func(t *testing.T) {
+		assert.EqualError(t, Select().Limit(-1).Err(), "LIMIT cannot be negative")
+		assert.EqualError(t, Select().Offset(-1).Err(), "OFFSET cannot be negative")
+	})
+
+	t.Run("should record an invalid column in ORDER BY", func(t *testing.T) {
+		stmt := Select().
+			From(sst.NewTableRef("users")).
+			OrderBy(sst.Asc(sst.NewColumnRef("users", "")))
+
+		assert.EqualError(t, stmt.Err(), "invalid column name: identifier cannot be empty")
+	})
+}
+
+func TestSelectRecordsInvalidIdentifiers(t *testing.T) {
+	t.Run("should record an invalid projected column", func(t *testing.T) {
+		stmt := Select(sst.NewColumnRef("users", ""))
+
+		assert.EqualError(t, stmt.Err(), "invalid column name: identifier cannot be empty")
+	})
+
+	t.Run("should record an invalid FROM table", func(t *testing.T) {
+		stmt := Select().From(sst.NewTableRef(""))
+
+		assert.EqualError(t, stmt.Err(), "invalid table name: identifier cannot be empty")
+	})
+
+	t.Run("should record an invalid JOIN table", func(t *testing.T) {
+		stmt := Select().
+			From(sst.NewTableRef("users")).
+			Join(sst.NewTableRef("orders\x00"))
+
+		assert.EqualError(t, stmt.Err(), `invalid table name: identifier "orders\x00" contains a NUL byte`)
+	})
+
+	t.Run("should record an invalid column in WHERE", func(t *testing.T) {
+		stmt := Select().
+			From(sst.NewTableRef("users")).
+			Where(sst.Eq(sst.NewColumnRef("users", "id\x00"), sst.NewBindParam(1)))
+
+		assert.EqualError(t, stmt.Err(), `invalid column name: identifier "id\x00" contains a NUL byte`)
+	})
+
+	t.Run("should record an invalid column in ON", func(t *testing.T) {
+		stmt := Select().
+			From(sst.NewTableRef("users")).
+			Join(sst.NewTableRef("orders")).
+			On(sst.Eq(sst.NewColumnRef("", "id"), sst.NewColumnRef("orders", "")))
+
+		assert.EqualError(t, stmt.Err(), "invalid column name: identifier cannot be empty")
+	})
+
+	t.Run("should reject ON on a CROSS JOIN", func(t *testing.T) {
+		stmt := Select().
+			From(sst.NewTableRef("users")).
+			CrossJoin(sst.NewTableRef("orders")).
+			On(sst.Eq(sst.NewColumnRef("users", "id"), sst.NewColumnRef("orders", "user_id")))
+
+		assert.EqualError(t, stmt.Err(), "CROSS JOIN cannot have an ON condition")
+	})
+
+	t.Run("should reject USING on a NATURAL JOIN", func(t *testing.T) {
+		stmt := Select().
+			From(sst.NewTableRef("users")).
+			NaturalJoin(sst.NewTableRef("orders")).
+			Using("id")
+
+		assert.EqualError(t, stmt.Err(), "NATURAL JOIN cannot have a USING condition")
+	})
+
+	t.Run("should record invalid USING columns", func(t *testing.T) {
+		stmt := Select().
+			From(sst.NewTableRef("users")).
+			Join(sst.NewTableRef("orders")).
+			Using("id", "")
+
+		assert.EqualError(t, stmt.Err(), "invalid USING column: identifier cannot be empty")
+	})
+
+	t.Run("should validate USING columns of a constructed join", func(t *testing.T) {
+		join := NewJoin(
+			NewFromSource(sst.NewTableRef("users")),
+			NewFromSource(sst.NewTableRef("orders")),
+		)
+		join.SetUsing([]string{"id\x00"})
+
+		assert.EqualError(t, sst.ValidateIdentifiers(join), `invalid USING column: identifier "id\x00" contains a NUL byte`)
+	})
+
+	t.Run("should require USING columns and a pending JOIN", func(t *testing.T) {
+		empty := Select().
+			From(sst.NewTableRef("users")).
+			Join(sst.NewTableRef("orders")).
+			Using()
+		pending := Select().
+			From(sst.NewTableRef("users")).
+			Using("id")
+
+		assert.EqualError(t, empty.Err(), "USING requires at least one column")
+		assert.EqualError(t, pending.Err(), "USING requires a pending JOIN")
+	})
+}
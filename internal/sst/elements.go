@@ -80,6 +80,7 @@ func (c *ColumnRef) Table() string {
 type TableRef struct {
 	name   string
 	schema string
+	alias  string
 }
 
 var _ TableRefNode = (*TableRef)(nil)
@@ -108,6 +109,15 @@ func WithTableSchema(schema string) TableRefOption {
 	}
 }
 
+// WithTableAlias gives a table reference a correlation name, as in
+// users AS u1. Column references then qualify by the alias, which allows the
+// same table to appear more than once in a statement.
+func WithTableAlias(alias string) TableRefOption {
+	return func(tr *TableRef) {
+		tr.alias = alias
+	}
+}
+
 // Accept dispatches the table reference node to the provided visitor.
 func (tr *TableRef) Accept(v Visitor) error {
 	return v.VisitTableRef(tr)
@@ -122,3 +132,17 @@ func (tr *TableRef) Name() string {
 func (tr *TableRef) Schema() string {
 	return tr.schema
 }
+
+// Alias returns the optional correlation name.
+func (tr *TableRef) Alias() string {
+	return tr.alias
+}
+
+// Column creates a column reference qualified by the table alias when one is
+// set, and otherwise by the table name and schema.
+func (tr *TableRef) Column(name string) *ColumnRef {
+	if tr.alias != "" {
+		return NewColumnRef(tr.alias, name)
+	}
+	return NewColumnRef(tr.name, name, WithColumnSchema(tr.schema))
+}
@@ -3,6 +3,7 @@ package sst
 import (
 	"testing"
 
+	"github.com/candango/sqlok/internal/schema"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,3 +18,18 @@ func TestNewTableRefWithSchema(t *testing.T) {
 
 	assert.Equal(t, "public", table.Schema())
 }
+
+func TestTableRefColumnQualifiesByAlias(t *testing.T) {
+	aliased := NewTableRef("users", WithTableSchema("public"), WithTableAlias("u"))
+	plain := NewTableRef("users", WithTableSchema("public"))
+
+	assert.Equal(t, "u.id", aliased.Column("id").Expr())
+	assert.Equal(t, "public.users.id", plain.Column("id").Expr())
+}
+
+func TestNewBindParamWithFieldType(t *testing.T) {
+	field := &schema.Field{FieldName: "payload", Type: "jsonb"}
+	param := NewBindParam(`{}`, WithFieldType(field))
+
+	assert.Equal(t, "jsonb", param.Type())
+}
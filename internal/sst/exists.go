@@ -0,0 +1,83 @@
+package sst
+
+import "errors"
+
+// ExistsExpressionNode represents an EXISTS or NOT EXISTS test over a
+// subquery. The subquery may reference sources of the enclosing statement.
+type ExistsExpressionNode interface {
+	ExpressionNode
+
+	// Operator returns Exists or NotExists.
+	Operator() OtherOperators
+
+	// Query returns the tested subquery.
+	Query() SelectStatementNode
+}
+
+// ExistsExpression represents [NOT] EXISTS (SELECT ...).
+type ExistsExpression struct {
+	op    OtherOperators
+	query SelectStatementNode
+}
+
+var _ ExistsExpressionNode = (*ExistsExpression)(nil)
+
+// NewExistsExpression creates an existence test with the provided operator,
+// Exists or NotExists.
+func NewExistsExpression(query SelectStatementNode, op OtherOperators) *ExistsExpression {
+	return &ExistsExpression{
+		op:    op,
+		query: query,
+	}
+}
+
+// ExistsSubquery creates an EXISTS (SELECT ...) expression.
+func ExistsSubquery(query SelectStatementNode) *ExistsExpression {
+	return NewExistsExpression(query, Exists)
+}
+
+// NotExistsSubquery creates a NOT EXISTS (SELECT ...) expression.
+func NotExistsSubquery(query SelectStatementNode) *ExistsExpression {
+	return NewExistsExpression(query, NotExists)
+}
+
+// Expr returns the existence keyword and trailing space.
+func (e *ExistsExpression) Expr() string {
+	if e.op == NotExists {
+		return "NOT EXISTS "
+	}
+	return "EXISTS "
+}
+
+// Accept dispatches the existence keyword and traverses the grouped
+// subquery, so bind arguments of the subquery are visited in SQL order.
+func (e *ExistsExpression) Accept(v Visitor) error {
+	switch e.op {
+	case Exists, NotExists:
+	default:
+		return errors.New("unsupported exists operator")
+	}
+	if e.query == nil {
+		return errors.New("EXISTS subquery cannot be nil")
+	}
+	if err := v.VisitExpression(e); err != nil {
+		return err
+	}
+	if err := v.VisitExpressionGroupStart(); err != nil {
+		return err
+	}
+	if err := e.query.Accept(v); err != nil {
+		return err
+	}
+	return v.VisitExpressionGroupEnd()
+}
+
+// Operator returns Exists or NotExists.
+func (e *ExistsExpression) Operator() OtherOperators {
+	return e.op
+}
+
+// Query returns the tested subquery.
+func (e *ExistsExpression) Query() SelectStatementNode {
+	return e.query
+}
@@ -3,6 +3,8 @@ package sst
 import (
 	"errors"
 	"fmt"
+
+	"github.com/candango/sqlok/internal/schema"
 )
 
 // ExpressionNode represents a SQL expression that can participate in a
@@ -13,11 +15,14 @@ type ExpressionNode interface {
 }
 
 const (
-	atomicExpressionPrecedence     = 4
-	comparisonExpressionPrecedence = 3
-	notExpressionPrecedence        = 3
-	andExpressionPrecedence        = 2
-	orExpressionPrecedence         = 1
+	atomicExpressionPrecedence         = 8
+	multiplicativeExpressionPrecedence = 7
+	additiveExpressionPrecedence       = 6
+	concatExpressionPrecedence         = 5
+	comparisonExpressionPrecedence     = 4
+	notExpressionPrecedence            = 3
+	andExpressionPrecedence            = 2
+	orExpressionPrecedence             = 1
 )
 
 type precedenceNode interface {
@@ -31,6 +36,32 @@ func expressionPrecedence(expr ExpressionNode) int {
 	return atomicExpressionPrecedence
 }
 
+// AcceptComparisonOperand traverses an operand of a comparison-level
+// predicate, grouping operands that do not bind tighter than a comparison.
+// Visitors that own the traversal of predicate nodes use it to keep the same
+// grouping as the nodes' own Accept methods.
+func AcceptComparisonOperand(v Visitor, operand ExpressionNode) error {
+	grouped := expressionPrecedence(operand) <= comparisonExpressionPrecedence
+	return acceptGrouped(v, operand, grouped)
+}
+
+// acceptGrouped traverses expr, wrapping it in an expression group when
+// grouped is true.
+func acceptGrouped(v Visitor, expr ExpressionNode, grouped bool) error {
+	if grouped {
+		if err := v.VisitExpressionGroupStart(); err != nil {
+			return err
+		}
+	}
+	if err := expr.Accept(v); err != nil {
+		return err
+	}
+	if grouped {
+		return v.VisitExpressionGroupEnd()
+	}
+	return nil
+}
+
 // ExpressionList represents a comma-separated list of expressions.
 type ExpressionList struct {
 	items []ExpressionNode
@@ -83,6 +114,13 @@ type LogicalExpressionNode interface {
 type BindParamNode interface {
 	ExpressionNode
 
+	// Name returns the optional bind name used by named placeholder styles.
+	Name() string
+
+	// Type returns the optional SQL type hint, rendered as a cast of the
+	// placeholder.
+	Type() string
+
 	// Value returns the runtime argument associated with the expression.
 	Value() any
 }
@@ -126,9 +164,10 @@ func (e *BinaryExpression) precedence() int {
 }
 
 // Accept traverses the operands and dispatches the binary expression between
-// them so visitors can render infix operators in the correct order.
+// them so visitors can render infix operators in the correct order. Operands
+// that do not bind tighter than a comparison are grouped.
 func (e *BinaryExpression) Accept(v Visitor) error {
-	if err := e.Left().Accept(v); err != nil {
+	if err := AcceptComparisonOperand(v, e.Left()); err != nil {
 		return err
 	}
 	switch e.Operator() {
@@ -144,7 +183,7 @@ func (e *BinaryExpression) Accept(v Visitor) error {
 	default:
 		return errors.New("unsupported comparison operator")
 	}
-	return e.Right().Accept(v)
+	return AcceptComparisonOperand(v, e.Right())
 }
 
 // Left returns the left expression operand.
@@ -221,20 +260,7 @@ func (e *LogicalExpression) Accept(v Visitor) error {
 }
 
 func (e *LogicalExpression) acceptOperand(v Visitor, operand ExpressionNode) error {
-	grouped := expressionPrecedence(operand) < e.precedence()
-	if grouped {
-		if err := v.VisitExpressionGroupStart(); err != nil {
-			return err
-		}
-	}
-
-	if err := operand.Accept(v); err != nil {
-		return err
-	}
-	if grouped {
-		return v.VisitExpressionGroupEnd()
-	}
-	return nil
+	return acceptGrouped(v, operand, expressionPrecedence(operand) < e.precedence())
 }
 
 func (e *LogicalExpression) precedence() int {
@@ -275,7 +301,9 @@ func (e *NotExpression) Expr() string {
 	return "NOT "
 }
 
-// Accept renders NOT and traverses its operand, grouping compound expressions.
+// Accept renders NOT and traverses its operand, grouping every non-atomic
+// operand other than a nested NOT so predicates such as IS NULL or IN read
+// unambiguously.
 func (e *NotExpression) Accept(v Visitor) error {
 	if e.operand == nil {
 		return errors.New("NOT requires an expression")
@@ -284,34 +312,15 @@ func (e *NotExpression) Accept(v Visitor) error {
 		return err
 	}
 
-	grouped := isCompoundExpression(e.operand)
-	if grouped {
-		if err := v.VisitExpressionGroupStart(); err != nil {
-			return err
-		}
-	}
-	if err := e.operand.Accept(v); err != nil {
-		return err
-	}
-	if grouped {
-		return v.VisitExpressionGroupEnd()
-	}
-	return nil
+	_, nested := e.operand.(*NotExpression)
+	grouped := !nested && expressionPrecedence(e.operand) < atomicExpressionPrecedence
+	return acceptGrouped(v, e.operand, grouped)
 }
 
 func (e *NotExpression) precedence() int {
 	return notExpressionPrecedence
 }
 
-func isCompoundExpression(expr ExpressionNode) bool {
-	switch expr.(type) {
-	case BinaryExpressionNode, LogicalExpressionNode:
-		return true
-	default:
-		return false
-	}
-}
-
 // Operand returns the expression being negated.
 func (e *NotExpression) Operand() ExpressionNode {
 	return e.operand
@@ -319,14 +328,48 @@ func (e *NotExpression) Operand() ExpressionNode {
 
 // BindParam represents a runtime argument rendered as a placeholder.
 type BindParam struct {
-	value any
+	name    string
+	sqlType string
+	value   any
 }
 
 var _ BindParamNode = (*BindParam)(nil)
 
-// NewBindParam creates a bind-parameter expression for the provided value.
-func NewBindParam(value any) *BindParam {
-	return &BindParam{value: value}
+// BindParamOption configures a bind parameter during construction.
+type BindParamOption func(*BindParam)
+
+// NewBindParam creates a bind-parameter expression for the provided value and
+// applies the provided construction options.
+func NewBindParam(value any, options ...BindParamOption) *BindParam {
+	p := &BindParam{value: value}
+
+	for _, option := range options {
+		option(p)
+	}
+
+	return p
+}
+
+// WithBindName names a bind parameter for named placeholder styles.
+func WithBindName(name string) BindParamOption {
+	return func(p *BindParam) {
+		p.name = name
+	}
+}
+
+// WithBindType gives a bind parameter a SQL type hint, so the placeholder is
+// rendered as a cast for databases that cannot infer the parameter type. Type
+// names use the strings stored in schema.Field.Type.
+func WithBindType(sqlType string) BindParamOption {
+	return func(p *BindParam) {
+		p.sqlType = sqlType
+	}
+}
+
+// WithFieldType gives a bind parameter the SQL type hint of a loaded schema
+// field, as WithBindType(field.Type) does.
+func WithFieldType(field *schema.Field) BindParamOption {
+	return WithBindType(field.Type)
 }
 
 // Accept dispatches the bind-parameter expression to the provided visitor.
@@ -339,11 +382,37 @@ func (p *BindParam) Expr() string {
 	return "?"
 }
 
+// Name returns the optional bind name.
+func (p *BindParam) Name() string {
+	return p.name
+}
+
+// Type returns the optional SQL type hint.
+func (p *BindParam) Type() string {
+	return p.sqlType
+}
+
 // Value returns the runtime value collected by the compiler.
 func (p *BindParam) Value() any {
 	return p.value
 }
 
+// keyword is an SQL keyword or punctuation token emitted between the operands
+// of a composite expression, such as the AND separating BETWEEN bounds.
+type keyword string
+
+var _ ExpressionNode = keyword("")
+
+// Expr returns the keyword text.
+func (k keyword) Expr() string {
+	return string(k)
+}
+
+// Accept dispatches the keyword to the provided visitor.
+func (k keyword) Accept(v Visitor) error {
+	return v.VisitExpression(k)
+}
+
 // Literal represents an expression rendered directly as SQL text.
 type Literal struct {
 	value any
@@ -0,0 +1,111 @@
+package sst
+
+import "fmt"
+
+// FunctionCallNode represents a call to a SQL function by name.
+type FunctionCallNode interface {
+	ExpressionNode
+
+	// Name returns the function name.
+	Name() string
+
+	// Arguments returns the function arguments in order.
+	Arguments() *ExpressionList
+}
+
+// FunctionCall represents name(arguments...). The name is rendered as written,
+// without identifier quoting, so it is restricted to letters, digits,
+// underscores, and dots separating a schema qualifier.
+type FunctionCall struct {
+	name      string
+	arguments *ExpressionList
+}
+
+var _ FunctionCallNode = (*FunctionCall)(nil)
+
+// NewFunctionCall creates a call to the named function with the provided
+// arguments.
+func NewFunctionCall(name string, arguments ...ExpressionNode) *FunctionCall {
+	return &FunctionCall{
+		name:      name,
+		arguments: NewExpressionList(arguments...),
+	}
+}
+
+// Func creates a call to the named function with the provided arguments.
+func Func(name string, arguments ...ExpressionNode) *FunctionCall {
+	return NewFunctionCall(name, arguments...)
+}
+
+// Coalesce creates a COALESCE(arguments...) call.
+func Coalesce(arguments ...ExpressionNode) *FunctionCall {
+	return NewFunctionCall("COALESCE", arguments...)
+}
+
+// Lower creates a LOWER(argument) call.
+func Lower(argument ExpressionNode) *FunctionCall {
+	return NewFunctionCall("LOWER", argument)
+}
+
+// Upper creates an UPPER(argument) call.
+func Upper(argument ExpressionNode) *FunctionCall {
+	return NewFunctionCall("UPPER", argument)
+}
+
+// Expr returns the function name.
+func (f *FunctionCall) Expr() string {
+	return f.name
+}
+
+// Accept dispatches the function name and traverses the grouped argument
+// list, so bind arguments are visited in SQL order.
+func (f *FunctionCall) Accept(v Visitor) error {
+	if !validFunctionName(f.name) {
+		return fmt.Errorf("invalid function name %q", f.name)
+	}
+	for _, argument := range f.arguments.Items() {
+		if argument == nil {
+			return fmt.Errorf("%s argument cannot be nil", f.name)
+		}
+	}
+
+	if err := v.VisitExpression(f); err != nil {
+		return err
+	}
+	if err := v.VisitExpressionGroupStart(); err != nil {
+		return err
+	}
+	if err := f.arguments.Accept(v); err != nil {
+		return err
+	}
+	return v.VisitExpressionGroupEnd()
+}
+
+// Name returns the function name.
+func (f *FunctionCall) Name() string {
+	return f.name
+}
+
+// Arguments returns the function arguments in order.
+func (f *FunctionCall) Arguments() *ExpressionList {
+	return f.arguments
+}
+
+// validFunctionName reports whether name is made of dot-separated parts that
+// start with a letter or underscore and continue with letters, digits, or
+// underscores.
+func validFunctionName(name string) bool {
+	start := true
+	for _, r := range name {
+		switch {
+		case r == '.' && !start:
+			start = true
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_':
+			start = false
+		case r >= '0' && r <= '9' && !start:
+		default:
+			return false
+		}
+	}
+	return name != "" && !start
+}
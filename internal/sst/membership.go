@@ -0,0 +1,179 @@
+package sst
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// InExpressionNode represents an IN or NOT IN membership test against either
+// a list of expressions or a subquery.
+type InExpressionNode interface {
+	ExpressionNode
+
+	// Subject returns the expression tested for membership.
+	Subject() ExpressionNode
+
+	// Operator returns the membership operator.
+	Operator() MembershipOperator
+
+	// Values returns the candidate list, or nil for the subquery form.
+	Values() *ExpressionList
+
+	// Query returns the candidate subquery, or nil for the list form.
+	Query() SelectStatementNode
+}
+
+// InExpression represents subject [NOT] IN (values...) or
+// subject [NOT] IN (SELECT ...).
+type InExpression struct {
+	subject ExpressionNode
+	op      MembershipOperator
+	values  *ExpressionList
+	query   SelectStatementNode
+}
+
+var _ InExpressionNode = (*InExpression)(nil)
+
+// NewInExpression creates a membership test against a list of values. Bind
+// parameters holding a slice or array, other than []byte, are expanded into
+// one bind parameter per element so each element gets its own placeholder.
+func NewInExpression(subject ExpressionNode, op MembershipOperator, values ...ExpressionNode) *InExpression {
+	return &InExpression{
+		subject: subject,
+		op:      op,
+		values:  NewExpressionList(expandBindValues(values)...),
+	}
+}
+
+// NewInSubquery creates a membership test against a subquery.
+func NewInSubquery(subject ExpressionNode, op MembershipOperator, query SelectStatementNode) *InExpression {
+	return &InExpression{
+		subject: subject,
+		op:      op,
+		query:   query,
+	}
+}
+
+// InList creates a subject IN (values...) expression.
+func InList(subject ExpressionNode, values ...ExpressionNode) *InExpression {
+	return NewInExpression(subject, In, values...)
+}
+
+// NotInList creates a subject NOT IN (values...) expression.
+func NotInList(subject ExpressionNode, values ...ExpressionNode) *InExpression {
+	return NewInExpression(subject, NotIn, values...)
+}
+
+// InSubquery creates a subject IN (SELECT ...) expression.
+func InSubquery(subject ExpressionNode, query SelectStatementNode) *InExpression {
+	return NewInSubquery(subject, In, query)
+}
+
+// NotInSubquery creates a subject NOT IN (SELECT ...) expression.
+func NotInSubquery(subject ExpressionNode, query SelectStatementNode) *InExpression {
+	return NewInSubquery(subject, NotIn, query)
+}
+
+// expandBindValues replaces slice-valued bind parameters with one bind
+// parameter per element, keeping the element order and the type hint. A bind
+// name becomes name_1, name_2, and so on, one suffix per element.
+func expandBindValues(values []ExpressionNode) []ExpressionNode {
+	expanded := make([]ExpressionNode, 0, len(values))
+	for _, value := range values {
+		param, ok := value.(BindParamNode)
+		if !ok {
+			expanded = append(expanded, value)
+			continue
+		}
+		rv := reflect.ValueOf(param.Value())
+		switch rv.Kind() {
+		case reflect.Slice, reflect.Array:
+			if rv.Type().Elem().Kind() == reflect.Uint8 {
+				expanded = append(expanded, value)
+				continue
+			}
+			for i := 0; i < rv.Len(); i++ {
+				options := []BindParamOption{WithBindType(param.Type())}
+				if name := param.Name(); name != "" {
+					options = append(options, WithBindName(fmt.Sprintf("%s_%d", name, i+1)))
+				}
+				expanded = append(expanded, NewBindParam(rv.Index(i).Interface(), options...))
+			}
+		default:
+			expanded = append(expanded, value)
+		}
+	}
+	return expanded
+}
+
+// Expr returns the membership operator token.
+func (e *InExpression) Expr() string {
+	if e.op == NotIn {
+		return " NOT IN "
+	}
+	return " IN "
+}
+
+func (e *InExpression) precedence() int {
+	return comparisonExpressionPrecedence
+}
+
+// Accept traverses the subject, dispatches the membership operator, and then
+// traverses the grouped candidate list or subquery. An empty list dispatches
+// only the expression itself so visitors can render a dialect-specific
+// constant predicate or reject it.
+func (e *InExpression) Accept(v Visitor) error {
+	switch e.op {
+	case In, NotIn:
+	default:
+		return errors.New("unsupported membership operator")
+	}
+	if e.subject == nil {
+		return errors.New("IN requires a subject expression")
+	}
+	if e.values != nil && len(e.values.Items()) == 0 {
+		return v.VisitExpression(e)
+	}
+	if e.values == nil && e.query == nil {
+		return errors.New("IN subquery cannot be nil")
+	}
+
+	if err := AcceptComparisonOperand(v, e.subject); err != nil {
+		return err
+	}
+	if err := v.VisitExpression(e); err != nil {
+		return err
+	}
+	if err := v.VisitExpressionGroupStart(); err != nil {
+		return err
+	}
+	if e.query != nil {
+		if err := e.query.Accept(v); err != nil {
+			return err
+		}
+	} else if err := e.values.Accept(v); err != nil {
+		return err
+	}
+	return v.VisitExpressionGroupEnd()
+}
+
+// Subject returns the expression tested for membership.
+func (e *InExpression) Subject() ExpressionNode {
+	return e.subject
+}
+
+// Operator returns the membership operator.
+func (e *InExpression) Operator() MembershipOperator {
+	return e.op
+}
+
+// Values returns the candidate list, or nil for the subquery form.
+func (e *InExpression) Values() *ExpressionList {
+	return e.values
+}
+
+// Query returns the candidate subquery, or nil for the list form.
+func (e *InExpression) Query() SelectStatementNode {
+	return e.query
+}
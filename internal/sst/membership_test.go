@@ -0,0 +1,30 @@
+package sst
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInExpressionExpandsSliceBindParams(t *testing.T) {
+	expr := InList(
+		NewColumnRef("users", "id"),
+		NewBindParam([]int{1, 2}),
+		NewBindParam([2]string{"a", "b"}),
+		NewBindParam([]byte("raw")),
+		NewLiteral(3),
+	)
+
+	items := expr.Values().Items()
+	values := make([]any, 0, len(items))
+	for _, item := range items {
+		if param, ok := item.(BindParamNode); ok {
+			values = append(values, param.Value())
+			continue
+		}
+		values = append(values, item.Expr())
+	}
+
+	assert.Equal(t, []any{1, 2, "a", "b", []byte("raw"), "3"}, values)
+	assert.Nil(t, expr.Query())
+}
@@ -0,0 +1,164 @@
+package sst
+
+import "errors"
+
+// NullExpressionNode represents an IS NULL or IS NOT NULL test.
+type NullExpressionNode interface {
+	ExpressionNode
+
+	// Operand returns the expression being tested.
+	Operand() ExpressionNode
+
+	// Operator returns the null-test operator.
+	Operator() NullOperator
+}
+
+// DistinctFromExpressionNode represents a null-safe IS [NOT] DISTINCT FROM
+// comparison between two expressions. Its rendering depends on the dialect,
+// so visitors own the traversal of its operands.
+type DistinctFromExpressionNode interface {
+	ExpressionNode
+
+	// Left returns the left expression operand.
+	Left() ExpressionNode
+
+	// Operator returns IsDistinctFrom or IsNotDistinctFrom.
+	Operator() OtherOperators
+
+	// Right returns the right expression operand.
+	Right() ExpressionNode
+}
+
+// NullExpression represents operand IS [NOT] NULL.
+type NullExpression struct {
+	operand ExpressionNode
+	op      NullOperator
+}
+
+var _ NullExpressionNode = (*NullExpression)(nil)
+
+// NewNullExpression creates a null test with the provided operator.
+func NewNullExpression(operand ExpressionNode, op NullOperator) *NullExpression {
+	return &NullExpression{
+		operand: operand,
+		op:      op,
+	}
+}
+
+// IsNullExpr creates an operand IS NULL expression.
+func IsNullExpr(operand ExpressionNode) *NullExpression {
+	return NewNullExpression(operand, IsNull)
+}
+
+// IsNotNullExpr creates an operand IS NOT NULL expression.
+func IsNotNullExpr(operand ExpressionNode) *NullExpression {
+	return NewNullExpression(operand, IsNotNull)
+}
+
+// Expr returns the postfix null-test token.
+func (e *NullExpression) Expr() string {
+	if e.op == IsNotNull {
+		return " IS NOT NULL"
+	}
+	return " IS NULL"
+}
+
+func (e *NullExpression) precedence() int {
+	return comparisonExpressionPrecedence
+}
+
+// Accept traverses the operand and then dispatches the postfix null test.
+func (e *NullExpression) Accept(v Visitor) error {
+	switch e.op {
+	case IsNull, IsNotNull:
+	default:
+		return errors.New("unsupported null operator")
+	}
+	if e.operand == nil {
+		return errors.New("IS NULL requires an expression")
+	}
+	if err := AcceptComparisonOperand(v, e.operand); err != nil {
+		return err
+	}
+	return v.VisitExpression(e)
+}
+
+// Operand returns the expression being tested.
+func (e *NullExpression) Operand() ExpressionNode {
+	return e.operand
+}
+
+// Operator returns the null-test operator.
+func (e *NullExpression) Operator() NullOperator {
+	return e.op
+}
+
+// DistinctFromExpression represents left IS [NOT] DISTINCT FROM right.
+type DistinctFromExpression struct {
+	left  ExpressionNode
+	op    OtherOperators
+	right ExpressionNode
+}
+
+var _ DistinctFromExpressionNode = (*DistinctFromExpression)(nil)
+
+// NewDistinctFromExpression creates a null-safe comparison with the provided
+// operator, IsDistinctFrom or IsNotDistinctFrom.
+func NewDistinctFromExpression(left, right ExpressionNode, op OtherOperators) *DistinctFromExpression {
+	return &DistinctFromExpression{
+		left:  left,
+		op:    op,
+		right: right,
+	}
+}
+
+// DistinctFrom creates a left IS DISTINCT FROM right expression.
+func DistinctFrom(left, right ExpressionNode) *DistinctFromExpression {
+	return NewDistinctFromExpression(left, right, IsDistinctFrom)
+}
+
+// NotDistinctFrom creates a left IS NOT DISTINCT FROM right expression.
+func NotDistinctFrom(left, right ExpressionNode) *DistinctFromExpression {
+	return NewDistinctFromExpression(left, right, IsNotDistinctFrom)
+}
+
+// Expr returns the standard SQL operator token.
+func (e *DistinctFromExpression) Expr() string {
+	if e.op == IsNotDistinctFrom {
+		return " IS NOT DISTINCT FROM "
+	}
+	return " IS DISTINCT FROM "
+}
+
+func (e *DistinctFromExpression) precedence() int {
+	return comparisonExpressionPrecedence
+}
+
+// Accept validates the expression and dispatches it to the visitor, which
+// renders the dialect form and traverses the operands.
+func (e *DistinctFromExpression) Accept(v Visitor) error {
+	switch e.op {
+	case IsDistinctFrom, IsNotDistinctFrom:
+	default:
+		return errors.New("unsupported distinct-from operator")
+	}
+	if e.left == nil || e.right == nil {
+		return errors.New("IS DISTINCT FROM requires two expressions")
+	}
+	return v.VisitDistinctFrom(e)
+}
+
+// Left returns the left expression operand.
+func (e *DistinctFromExpression) Left() ExpressionNode {
+	return e.left
+}
+
+// Operator returns IsDistinctFrom or IsNotDistinctFrom.
+func (e *DistinctFromExpression) Operator() OtherOperators {
+	return e.op
+}
+
+// Right returns the right expression operand.
+func (e *DistinctFromExpression) Right() ExpressionNode {
+	return e.right
+}
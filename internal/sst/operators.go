@@ -11,6 +11,17 @@ const (
 	LessThanOrEqual    ComparisonOperator = "<="
 )
 
+// ArithmeticOperator identifies a SQL arithmetic operator.
+type ArithmeticOperator string
+
+const (
+	AddOperator      ArithmeticOperator = "+"
+	SubtractOperator ArithmeticOperator = "-"
+	MultiplyOperator ArithmeticOperator = "*"
+	DivideOperator   ArithmeticOperator = "/"
+	ModuloOperator   ArithmeticOperator = "%"
+)
+
 type MembershipOperator uint8
 
 const (
@@ -37,7 +48,8 @@ type PatternOperator uint8
 const (
 	Like PatternOperator = iota
 	NotLike
-	// ILike e NoILike go to dialects
+	// Case-insensitive matching is a PatternExpression option; dialects
+	// decide between ILIKE and LOWER(...) LIKE LOWER(...).
 )
 
 // BooleanOperator identifies a logical SQL operator.
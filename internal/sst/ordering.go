@@ -0,0 +1,156 @@
+package sst
+
+import "errors"
+
+// OrderDirection identifies the sort direction of an ordering term.
+type OrderDirection string
+
+const (
+	// DefaultDirection leaves the direction to the database, which sorts
+	// ascending.
+	DefaultDirection OrderDirection = ""
+	Ascending        OrderDirection = "ASC"
+	Descending       OrderDirection = "DESC"
+)
+
+// NullsOrder identifies where NULL values are placed by an ordering term.
+type NullsOrder uint8
+
+const (
+	// NullsDefault leaves NULL placement to the database.
+	NullsDefault NullsOrder = iota
+	NullsFirst
+	NullsLast
+)
+
+// OrderingNode represents one ORDER BY term. Placement of NULL values depends
+// on the dialect, so visitors own the traversal of its expression.
+type OrderingNode interface {
+	ExpressionNode
+
+	// Expression returns the sorted expression.
+	Expression() ExpressionNode
+
+	// Direction returns the sort direction.
+	Direction() OrderDirection
+
+	// Nulls returns the requested NULL placement.
+	Nulls() NullsOrder
+}
+
+// LimitPosition identifies where a statement offers its row-limiting clause
+// to visitors. Statements dispatch the clause at both positions and the
+// visitor renders it at the one its dialect requires.
+type LimitPosition uint8
+
+const (
+	// LimitAfterDeclaration follows the statement keyword, as in
+	// SELECT TOP (n).
+	LimitAfterDeclaration LimitPosition = iota
+	// LimitAfterOrdering follows ORDER BY, as in LIMIT n OFFSET m or
+	// OFFSET m ROWS FETCH NEXT n ROWS ONLY.
+	LimitAfterOrdering
+)
+
+// LimitClauseNode represents the row-limiting clause of a query.
+type LimitClauseNode interface {
+	ClauseNode
+
+	// Limit returns the maximum number of rows, or nil when unbounded.
+	Limit() ExpressionNode
+
+	// Offset returns the number of skipped rows, or nil when none are skipped.
+	Offset() ExpressionNode
+
+	// Ordered reports whether the limited query has an ORDER BY clause.
+	Ordered() bool
+}
+
+// OrderingTerm represents expression [ASC|DESC] [NULLS FIRST|LAST].
+type OrderingTerm struct {
+	expr      ExpressionNode
+	direction OrderDirection
+	nulls     NullsOrder
+}
+
+var _ OrderingNode = (*OrderingTerm)(nil)
+
+// OrderingOption configures an ordering term during construction.
+type OrderingOption func(*OrderingTerm)
+
+// NewOrderingTerm creates an ordering term with the provided direction and
+// applies the provided construction options.
+func NewOrderingTerm(expr ExpressionNode, direction OrderDirection, options ...OrderingOption) *OrderingTerm {
+	o := &OrderingTerm{
+		expr:      expr,
+		direction: direction,
+	}
+
+	for _, option := range options {
+		option(o)
+	}
+
+	return o
+}
+
+// WithNullsFirst places NULL values before non-NULL values.
+func WithNullsFirst() OrderingOption {
+	return func(o *OrderingTerm) {
+		o.nulls = NullsFirst
+	}
+}
+
+// WithNullsLast places NULL values after non-NULL values.
+func WithNullsLast() OrderingOption {
+	return func(o *OrderingTerm) {
+		o.nulls = NullsLast
+	}
+}
+
+// Asc creates an ascending ordering term.
+func Asc(expr ExpressionNode, options ...OrderingOption) *OrderingTerm {
+	return NewOrderingTerm(expr, Ascending, options...)
+}
+
+// Desc creates a descending ordering term.
+func Desc(expr ExpressionNode, options ...OrderingOption) *OrderingTerm {
+	return NewOrderingTerm(expr, Descending, options...)
+}
+
+// Expr returns the direction token with a leading space, or an empty string
+// for the default direction.
+func (o *OrderingTerm) Expr() string {
+	if o.direction == DefaultDirection {
+		return ""
+	}
+	return " " + string(o.direction)
+}
+
+// Accept validates the term and dispatches it to the visitor, which renders
+// the expression, direction, and NULL placement.
+func (o *OrderingTerm) Accept(v Visitor) error {
+	if o.expr == nil {
+		return errors.New("ORDER BY requires an expression")
+	}
+	switch o.direction {
+	case DefaultDirection, Ascending, Descending:
+	default:
+		return errors.New("unsupported order direction")
+	}
+	return v.VisitOrdering(o)
+}
+
+// Expression returns the sorted expression.
+func (o *OrderingTerm) Expression() ExpressionNode {
+	return o.expr
+}
+
+// Direction returns the sort direction.
+func (o *OrderingTerm) Direction() OrderDirection {
+	return o.direction
+}
+
+// Nulls returns the requested NULL placement.
+func (o *OrderingTerm) Nulls() NullsOrder {
+	return o.nulls
+}
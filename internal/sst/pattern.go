@@ -0,0 +1,191 @@
+package sst
+
+import (
+	"errors"
+	"strings"
+)
+
+// DefaultLikeEscape is the escape character used by Contains, StartsWith, and
+// EndsWith when escaping user-supplied values.
+const DefaultLikeEscape = '\\'
+
+// PatternExpressionNode represents a LIKE or NOT LIKE pattern match. Its
+// rendering depends on the dialect when the match is case-insensitive, so
+// visitors own the traversal of its operands.
+type PatternExpressionNode interface {
+	ExpressionNode
+
+	// Subject returns the expression matched against the pattern.
+	Subject() ExpressionNode
+
+	// Operator returns the pattern operator.
+	Operator() PatternOperator
+
+	// Pattern returns the pattern expression.
+	Pattern() ExpressionNode
+
+	// Escape returns the ESCAPE character, or 0 when none is declared.
+	Escape() rune
+
+	// CaseInsensitive reports whether the match ignores letter case.
+	CaseInsensitive() bool
+}
+
+// PatternExpression represents subject [NOT] LIKE pattern [ESCAPE char].
+type PatternExpression struct {
+	subject         ExpressionNode
+	op              PatternOperator
+	pattern         ExpressionNode
+	escape          rune
+	caseInsensitive bool
+}
+
+var _ PatternExpressionNode = (*PatternExpression)(nil)
+
+// PatternOption configures a pattern expression during construction.
+type PatternOption func(*PatternExpression)
+
+// NewPatternExpression creates a pattern match with the provided operator and
+// applies the provided construction options.
+func NewPatternExpression(subject, pattern ExpressionNode, op PatternOperator, options ...PatternOption) *PatternExpression {
+	e := &PatternExpression{
+		subject: subject,
+		op:      op,
+		pattern: pattern,
+	}
+
+	for _, option := range options {
+		option(e)
+	}
+
+	return e
+}
+
+// WithEscape declares the ESCAPE character of the pattern.
+func WithEscape(escape rune) PatternOption {
+	return func(e *PatternExpression) {
+		e.escape = escape
+	}
+}
+
+// WithCaseInsensitive makes the match ignore letter case. Dialects render it
+// as ILIKE or as LOWER(subject) LIKE LOWER(pattern).
+func WithCaseInsensitive() PatternOption {
+	return func(e *PatternExpression) {
+		e.caseInsensitive = true
+	}
+}
+
+// LikeExpr creates a subject LIKE pattern expression.
+func LikeExpr(subject, pattern ExpressionNode, options ...PatternOption) *PatternExpression {
+	return NewPatternExpression(subject, pattern, Like, options...)
+}
+
+// NotLikeExpr creates a subject NOT LIKE pattern expression.
+func NotLikeExpr(subject, pattern ExpressionNode, options ...PatternOption) *PatternExpression {
+	return NewPatternExpression(subject, pattern, NotLike, options...)
+}
+
+// ILikeExpr creates a case-insensitive subject LIKE pattern expression.
+func ILikeExpr(subject, pattern ExpressionNode, options ...PatternOption) *PatternExpression {
+	options = append([]PatternOption{WithCaseInsensitive()}, options...)
+	return NewPatternExpression(subject, pattern, Like, options...)
+}
+
+// Contains creates a pattern match for subject values containing value. The
+// value is escaped and bound as %value%, so wildcard characters in it match
+// literally. The value is escaped with DefaultLikeEscape unless WithEscape
+// declares another character.
+func Contains(subject ExpressionNode, value string, options ...PatternOption) *PatternExpression {
+	return newEscapedPattern(subject, "%", value, "%", options)
+}
+
+// StartsWith creates a pattern match for subject values starting with value.
+// The value is escaped and bound as value%.
+func StartsWith(subject ExpressionNode, value string, options ...PatternOption) *PatternExpression {
+	return newEscapedPattern(subject, "", value, "%", options)
+}
+
+// EndsWith creates a pattern match for subject values ending with value. The
+// value is escaped and bound as %value.
+func EndsWith(subject ExpressionNode, value string, options ...PatternOption) *PatternExpression {
+	return newEscapedPattern(subject, "%", value, "", options)
+}
+
+// newEscapedPattern applies the options first, so the value is escaped with
+// the ESCAPE character the expression finally declares.
+func newEscapedPattern(subject ExpressionNode, prefix, value, suffix string, options []PatternOption) *PatternExpression {
+	e := NewPatternExpression(subject, nil, Like, options...)
+	if e.escape == 0 {
+		e.escape = DefaultLikeEscape
+	}
+	e.pattern = NewBindParam(prefix + EscapeLikePattern(value, e.escape) + suffix)
+	return e
+}
+
+// EscapeLikePattern escapes %, _, [, and the escape character itself in value
+// so that it matches literally inside a LIKE pattern declared with escape. The
+// [ opens a character class on SQL Server; other databases match an escaped [
+// as itself.
+func EscapeLikePattern(value string, escape rune) string {
+	var b strings.Builder
+	b.Grow(len(value))
+	for _, r := range value {
+		if r == '%' || r == '_' || r == '[' || r == escape {
+			b.WriteRune(escape)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Expr returns the standard LIKE operator token.
+func (e *PatternExpression) Expr() string {
+	if e.op == NotLike {
+		return " NOT LIKE "
+	}
+	return " LIKE "
+}
+
+func (e *PatternExpression) precedence() int {
+	return comparisonExpressionPrecedence
+}
+
+// Accept validates the expression and dispatches it to the visitor, which
+// renders the dialect form and traverses the operands.
+func (e *PatternExpression) Accept(v Visitor) error {
+	switch e.op {
+	case Like, NotLike:
+	default:
+		return errors.New("unsupported pattern operator")
+	}
+	if e.subject == nil || e.pattern == nil {
+		return errors.New("LIKE requires a subject and a pattern")
+	}
+	return v.VisitPattern(e)
+}
+
+// Subject returns the expression matched against the pattern.
+func (e *PatternExpression) Subject() ExpressionNode {
+	return e.subject
+}
+
+// Operator returns the pattern operator.
+func (e *PatternExpression) Operator() PatternOperator {
+	return e.op
+}
+
+// Pattern returns the pattern expression.
+func (e *PatternExpression) Pattern() ExpressionNode {
+	return e.pattern
+}
+
+// Escape returns the ESCAPE character, or 0 when none is declared.
+func (e *PatternExpression) Escape() rune {
+	return e.escape
+}
+
+// CaseInsensitive reports whether the match ignores letter case.
+func (e *PatternExpression) CaseInsensitive() bool {
+	return e.caseInsensitive
+}
@@ -0,0 +1,56 @@
+package sst
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscapeLikePattern(t *testing.T) {
+	assert.Equal(t, `50\% off\_now`, EscapeLikePattern("50% off_now", '\\'))
+	assert.Equal(t, `C:\\temp`, EscapeLikePattern(`C:\temp`, '\\'))
+	assert.Equal(t, `a!!b!%`, EscapeLikePattern("a!b%", '!'))
+	assert.Equal(t, `\[a]`, EscapeLikePattern("[a]", '\\'))
+}
+
+func TestPatternHelpersBindEscapedValues(t *testing.T) {
+	column := NewColumnRef("users", "name")
+
+	tests := []struct {
+		name    string
+		expr    *PatternExpression
+		pattern string
+	}{
+		{name: "contains", expr: Contains(column, "50%"), pattern: `%50\%%`},
+		{name: "starts with", expr: StartsWith(column, "a_b"), pattern: `a\_b%`},
+		{name: "ends with", expr: EndsWith(column, `x\`), pattern: `%x\\`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			param, ok := tt.expr.Pattern().(BindParamNode)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.pattern, param.Value())
+			assert.Equal(t, DefaultLikeEscape, tt.expr.Escape())
+			assert.Equal(t, Like, tt.expr.Operator())
+			assert.False(t, tt.expr.CaseInsensitive())
+		})
+	}
+
+	assert.True(t, Contains(column, "a", WithCaseInsensitive()).CaseInsensitive())
+}
+
+func TestPatternHelpersEscapeWithTheDeclaredEscape(t *testing.T) {
+	column := NewColumnRef("users", "name")
+
+	expr := Contains(column, `50%_x\!`, WithEscape('!'))
+
+	assert.Equal(t, '!', expr.Escape())
+	assert.Equal(t, `%50!%!_x\!!%`, expr.Pattern().(BindParamNode).Value())
+
+	expr = StartsWith(column, "a%", WithEscape(0))
+
+	assert.Equal(t, DefaultLikeEscape, expr.Escape())
+	assert.Equal(t, `a\%%`, expr.Pattern().(BindParamNode).Value())
+}
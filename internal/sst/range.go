@@ -0,0 +1,110 @@
+package sst
+
+import "errors"
+
+// RangeExpressionNode represents a BETWEEN or NOT BETWEEN range test.
+type RangeExpressionNode interface {
+	ExpressionNode
+
+	// Subject returns the expression tested against the range.
+	Subject() ExpressionNode
+
+	// Operator returns the range operator.
+	Operator() RangeOperator
+
+	// Lower returns the inclusive lower bound.
+	Lower() ExpressionNode
+
+	// Upper returns the inclusive upper bound.
+	Upper() ExpressionNode
+}
+
+// RangeExpression represents subject [NOT] BETWEEN lower AND upper.
+type RangeExpression struct {
+	subject ExpressionNode
+	op      RangeOperator
+	lower   ExpressionNode
+	upper   ExpressionNode
+}
+
+var _ RangeExpressionNode = (*RangeExpression)(nil)
+
+// NewRangeExpression creates a range test with the provided operator.
+func NewRangeExpression(subject, lower, upper ExpressionNode, op RangeOperator) *RangeExpression {
+	return &RangeExpression{
+		subject: subject,
+		op:      op,
+		lower:   lower,
+		upper:   upper,
+	}
+}
+
+// BetweenExpr creates a subject BETWEEN lower AND upper expression.
+func BetweenExpr(subject, lower, upper ExpressionNode) *RangeExpression {
+	return NewRangeExpression(subject, lower, upper, Between)
+}
+
+// NotBetweenExpr creates a subject NOT BETWEEN lower AND upper expression.
+func NotBetweenExpr(subject, lower, upper ExpressionNode) *RangeExpression {
+	return NewRangeExpression(subject, lower, upper, NotBetween)
+}
+
+// Expr returns the range operator token.
+func (e *RangeExpression) Expr() string {
+	if e.op == NotBetween {
+		return " NOT BETWEEN "
+	}
+	return " BETWEEN "
+}
+
+func (e *RangeExpression) precedence() int {
+	return comparisonExpressionPrecedence
+}
+
+// Accept traverses the subject, the range operator, and both bounds in SQL
+// order. Operands that do not bind tighter than a comparison are grouped so
+// a logical AND inside a bound cannot be read as the bound separator.
+func (e *RangeExpression) Accept(v Visitor) error {
+	switch e.op {
+	case Between, NotBetween:
+	default:
+		return errors.New("unsupported range operator")
+	}
+	if e.subject == nil || e.lower == nil || e.upper == nil {
+		return errors.New("BETWEEN requires a subject, a lower bound, and an upper bound")
+	}
+
+	if err := AcceptComparisonOperand(v, e.subject); err != nil {
+		return err
+	}
+	if err := v.VisitExpression(e); err != nil {
+		return err
+	}
+	if err := AcceptComparisonOperand(v, e.lower); err != nil {
+		return err
+	}
+	if err := keyword(" AND ").Accept(v); err != nil {
+		return err
+	}
+	return AcceptComparisonOperand(v, e.upper)
+}
+
+// Subject returns the expression tested against the range.
+func (e *RangeExpression) Subject() ExpressionNode {
+	return e.subject
+}
+
+// Operator returns the range operator.
+func (e *RangeExpression) Operator() RangeOperator {
+	return e.op
+}
+
+// Lower returns the inclusive lower bound.
+func (e *RangeExpression) Lower() ExpressionNode {
+	return e.lower
+}
+
+// Upper returns the inclusive upper bound.
+func (e *RangeExpression) Upper() ExpressionNode {
+	return e.upper
+}
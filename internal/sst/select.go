@@ -11,6 +11,39 @@ type SelectStatementNode interface {
 
 	// Source returns the primary FROM source.
 	Source() FromSourceNode
+
+	// Condition returns the WHERE condition, or nil.
+	Condition() ExpressionNode
+
+	// Ordering returns the ORDER BY terms, or nil when the statement is not
+	// ordered.
+	Ordering() *ExpressionList
+
+	// LimitClause returns the row-limiting clause, or nil when the statement
+	// has neither LIMIT nor OFFSET.
+	LimitClause() LimitClauseNode
+
+	// WithClause returns the WITH clause, or nil when the statement declares
+	// no common table expressions.
+	WithClause() WithClauseNode
+
+	// DistinctClause returns the DISTINCT modifier, or nil when the statement
+	// keeps duplicate rows.
+	DistinctClause() DistinctClauseNode
+}
+
+// DistinctClauseNode represents the DISTINCT modifier of a SELECT statement.
+// DISTINCT ON is dialect-specific, so visitors own the traversal of its
+// expressions.
+type DistinctClauseNode interface {
+	ClauseNode
+
+	// On returns the DISTINCT ON expressions, or nil for a plain DISTINCT.
+	On() *ExpressionList
+
+	// Ordering returns the ORDER BY terms of the statement, which DISTINCT ON
+	// expressions must lead, or nil when the statement is not ordered.
+	Ordering() *ExpressionList
 }
 
 // SelectBuilder represents the fluent construction API for a SELECT
@@ -19,6 +52,16 @@ type SelectStatementNode interface {
 type SelectBuilder interface {
 	SelectStatementNode
 
+	// With declares common table expressions ahead of the statement.
+	With(...CommonTableExpressionNode) SelectBuilder
+
+	// Distinct removes duplicate rows from the result.
+	Distinct() SelectBuilder
+
+	// DistinctOn keeps the first row of each group of rows with equal
+	// expressions.
+	DistinctOn(...ExpressionNode) SelectBuilder
+
 	// From sets the primary FROM source.
 	From(TableRefNode) SelectBuilder
 
@@ -37,9 +80,34 @@ type SelectBuilder interface {
 	// RightJoin adds a source with the RIGHT JOIN type.
 	RightJoin(TableRefNode) SelectBuilder
 
+	// FullOuterJoin adds a source with the FULL OUTER JOIN type.
+	FullOuterJoin(TableRefNode) SelectBuilder
+
+	// NaturalJoin adds a source with the NATURAL JOIN type.
+	NaturalJoin(TableRefNode) SelectBuilder
+
 	// On completes the most recently created JOIN.
 	On(Node) SelectBuilder
 
+	// Using completes the most recently created JOIN with shared columns.
+	Using(...string) SelectBuilder
+
 	// Where adds or combines a WHERE condition.
 	Where(ExpressionNode) SelectBuilder
+
+	// GroupBy appends GROUP BY expressions.
+	GroupBy(...ExpressionNode) SelectBuilder
+
+	// Having adds or combines a HAVING condition.
+	Having(ExpressionNode) SelectBuilder
+
+	// OrderBy appends ORDER BY terms. Expressions that are not ordering
+	// terms are sorted in the default direction.
+	OrderBy(...ExpressionNode) SelectBuilder
+
+	// Limit sets the maximum number of returned rows as a bind parameter.
+	Limit(int) SelectBuilder
+
+	// Offset sets the number of skipped rows as a bind parameter.
+	Offset(int) SelectBuilder
 }
@@ -4,13 +4,21 @@ package sst
 type JoinType string
 
 const (
-	Join      JoinType = "JOIN"
-	InnerJoin JoinType = "INNER JOIN"
-	CrossJoin JoinType = "CROSS JOIN"
-	LeftJoin  JoinType = "LEFT JOIN"
-	RightJoin JoinType = "RIGHT JOIN"
+	Join          JoinType = "JOIN"
+	InnerJoin     JoinType = "INNER JOIN"
+	CrossJoin     JoinType = "CROSS JOIN"
+	LeftJoin      JoinType = "LEFT JOIN"
+	RightJoin     JoinType = "RIGHT JOIN"
+	FullOuterJoin JoinType = "FULL OUTER JOIN"
+	NaturalJoin   JoinType = "NATURAL JOIN"
 )
 
+// Conditional reports whether the join type accepts an ON or USING
+// condition. CROSS and NATURAL joins take none.
+func (t JoinType) Conditional() bool {
+	return t != CrossJoin && t != NaturalJoin
+}
+
 // FromSourceNode represents a SELECT FROM source and its optional join link.
 //
 // Traversal advances from the source table to its Join and then through the
@@ -22,7 +30,8 @@ type FromSourceNode interface {
 	// Attach adds the next join to this source.
 	Attach(JoinNode) error
 
-	// Table returns the table source, when present.
+	// Table returns the table source, when present. Derived tables and common
+	// table expressions are table sources too.
 	Table() TableRefNode
 
 	// Join returns the next join attached to this source, when present.
@@ -47,6 +56,13 @@ type JoinNode interface {
 	// SetOn sets the condition while the statement builder completes this join.
 	SetOn(condition Node)
 
+	// Using returns the shared column names of a USING condition, or nil.
+	Using() []string
+
+	// SetUsing sets the USING columns while the statement builder completes
+	// this join.
+	SetUsing(columns []string)
+
 	// Right returns the source introduced by the join and the next traversal
 	// point in the source chain.
 	Right() FromSourceNode
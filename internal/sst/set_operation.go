@@ -0,0 +1,67 @@
+package sst
+
+// SetOperator identifies a SQL set operation combining two queries.
+type SetOperator string
+
+const (
+	UnionOperator     SetOperator = "UNION"
+	UnionAllOperator  SetOperator = "UNION ALL"
+	IntersectOperator SetOperator = "INTERSECT"
+	ExceptOperator    SetOperator = "EXCEPT"
+)
+
+// SetOperationNode represents a compound query root combining two queries
+// with a set operator. Chains are left-deep: a UNION b INTERSECT c is the
+// INTERSECT of (a UNION b) and c. Parenthesization depends on the dialect, so
+// visitors own the traversal of its operands.
+type SetOperationNode interface {
+	StatementNode
+
+	// Left returns the left query, a SELECT or another set operation.
+	Left() StatementNode
+
+	// Operator returns the set operator.
+	Operator() SetOperator
+
+	// Right returns the right query, a SELECT or another set operation.
+	Right() StatementNode
+
+	// Ordering returns the ORDER BY terms of the compound result, or nil.
+	Ordering() *ExpressionList
+
+	// LimitClause returns the row-limiting clause of the compound result, or
+	// nil.
+	LimitClause() LimitClauseNode
+
+	// WithClause returns the WITH clause declared ahead of the compound, or
+	// nil.
+	WithClause() WithClauseNode
+}
+
+// setOperatorPrecedence returns the binding strength of op. INTERSECT binds
+// tighter than UNION and EXCEPT in standard SQL.
+func setOperatorPrecedence(op SetOperator) int {
+	if op == IntersectOperator {
+		return 2
+	}
+	return 1
+}
+
+// SetOperandRequiresGroup reports whether an operand of the set operation op
+// must be parenthesized to keep its meaning: operands with their own WITH,
+// ORDER BY, or row limit, set operations on the right, and, when ranked is
+// true, set operations on the left whose operator binds looser than op.
+// Databases that evaluate every set operator left to right are not ranked.
+func SetOperandRequiresGroup(op SetOperationNode, operand StatementNode, right, ranked bool) bool {
+	switch node := operand.(type) {
+	case SelectStatementNode:
+		return node.WithClause() != nil || node.Ordering() != nil || node.LimitClause() != nil
+	case SetOperationNode:
+		if right || node.WithClause() != nil || node.Ordering() != nil || node.LimitClause() != nil {
+			return true
+		}
+		return ranked && setOperatorPrecedence(node.Operator()) < setOperatorPrecedence(op.Operator())
+	default:
+		return false
+	}
+}
@@ -0,0 +1,60 @@
+package sst
+
+import "errors"
+
+// SubqueryExpressionNode represents a query used as a scalar value, such as a
+// projected column or a comparison operand.
+type SubqueryExpressionNode interface {
+	ExpressionNode
+
+	// Query returns the nested query, a SELECT or a set operation.
+	Query() StatementNode
+}
+
+// SubqueryExpression represents (SELECT ...) in expression position. It
+// renders its own parentheses, so it is atomic as an operand.
+type SubqueryExpression struct {
+	query StatementNode
+}
+
+var _ SubqueryExpressionNode = (*SubqueryExpression)(nil)
+
+// NewSubqueryExpression creates a scalar subquery expression.
+func NewSubqueryExpression(query StatementNode) *SubqueryExpression {
+	return &SubqueryExpression{query: query}
+}
+
+// Subquery creates a scalar (SELECT ...) expression.
+func Subquery(query StatementNode) *SubqueryExpression {
+	return NewSubqueryExpression(query)
+}
+
+// Expr returns an empty string; the subquery renders only its grouped query.
+func (e *SubqueryExpression) Expr() string {
+	return ""
+}
+
+// Accept traverses the grouped query, so its bind arguments are visited at
+// the position of the subquery.
+func (e *SubqueryExpression) Accept(v Visitor) error {
+	switch e.query.(type) {
+	case SelectStatementNode, SetOperationNode:
+	case nil:
+		return errors.New("subquery cannot be nil")
+	default:
+		return errors.New("subquery must be a SELECT or set operation")
+	}
+
+	if err := v.VisitExpressionGroupStart(); err != nil {
+		return err
+	}
+	if err := e.query.Accept(v); err != nil {
+		return err
+	}
+	return v.VisitExpressionGroupEnd()
+}
+
+// Query returns the nested query.
+func (e *SubqueryExpression) Query() StatementNode {
+	return e.query
+}
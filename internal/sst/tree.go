@@ -59,6 +59,10 @@ type TableRefNode interface {
 
 	// Schema returns the optional schema qualifier.
 	Schema() string
+
+	// Alias returns the optional correlation name that column references
+	// use in place of the table name.
+	Alias() string
 }
 
 // Visitor defines operations that can be applied to SQL semantic tree nodes.
@@ -66,9 +70,34 @@ type Visitor interface {
 	// VisitColumnRef visits a SQL column reference node.
 	VisitColumnRef(ColumnRefNode) error
 
+	// VisitCast visits a type conversion. The visitor renders the dialect
+	// form and traverses the operand.
+	VisitCast(CastExpressionNode) error
+
 	// VisitClause visits a SQL clause declaration.
 	VisitClause(ClauseNode) error
 
+	// VisitConcat visits a string concatenation. The visitor renders the
+	// dialect form and traverses the operands.
+	VisitConcat(ConcatExpressionNode) error
+
+	// VisitDelete visits a DELETE statement root. The visitor renders the
+	// dialect form and traverses the target, sources, condition, and
+	// RETURNING clause.
+	VisitDelete(DeleteStatementNode) error
+
+	// VisitDerivedTable visits a subquery source. The visitor renders the
+	// dialect form and traverses the query.
+	VisitDerivedTable(DerivedTableNode) error
+
+	// VisitDistinct visits the DISTINCT modifier of a SELECT statement. The
+	// visitor renders the dialect form and traverses DISTINCT ON expressions.
+	VisitDistinct(DistinctClauseNode) error
+
+	// VisitDistinctFrom visits a null-safe comparison. The visitor renders
+	// the dialect form and traverses both operands.
+	VisitDistinctFrom(DistinctFromExpressionNode) error
+
 	// VisitExpression visits an expression node for SQL rendering.
 	VisitExpression(ExpressionNode) error
 
@@ -81,15 +110,47 @@ type Visitor interface {
 	// VisitFromSource visits a SELECT source and its attached joins.
 	VisitFromSource(FromSourceNode) error
 
+	// VisitInsert visits an INSERT statement root. The visitor renders the
+	// dialect form and traverses the target, the rows or source query, the
+	// conflict clause, and the RETURNING clause.
+	VisitInsert(InsertStatementNode) error
+
 	// VisitJoin visits a join relationship between SELECT sources.
 	VisitJoin(JoinNode) error
 
+	// VisitLimit visits the row-limiting clause at one of the positions a
+	// statement offers it. The visitor renders it at the position required
+	// by its dialect and ignores the other.
+	VisitLimit(LimitClauseNode, LimitPosition) error
+
 	// VisitListSeparator visits the separator position before a list item.
 	VisitListSeparator(index int) error
 
+	// VisitOrdering visits an ORDER BY term. The visitor renders the
+	// direction and NULL placement and traverses the sorted expression.
+	VisitOrdering(OrderingNode) error
+
+	// VisitPattern visits a LIKE pattern match. The visitor renders the
+	// dialect form and traverses the subject and pattern.
+	VisitPattern(PatternExpressionNode) error
+
+	// VisitSetOperation visits a compound query root. The visitor renders
+	// the operands with the parentheses its dialect requires, followed by the
+	// ordering and row limit of the compound result.
+	VisitSetOperation(SetOperationNode) error
+
 	// VisitStatement visits a SQL statement declaration.
 	VisitStatement(StatementNode) error
 
 	// VisitTableRef visits a SQL table reference node.
 	VisitTableRef(TableRefNode) error
+
+	// VisitUpdate visits an UPDATE statement root. The visitor renders the
+	// dialect form and traverses the target, assignments, sources, condition,
+	// and RETURNING clause.
+	VisitUpdate(UpdateStatementNode) error
+
+	// VisitWith visits the WITH clause of a statement root. The visitor
+	// renders the dialect form and traverses the declared queries.
+	VisitWith(WithClauseNode) error
 }
@@ -0,0 +1,349 @@
+package sst
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ValidateIdentifier reports whether name can be rendered as a SQL
+// identifier. Empty names, NUL bytes, and invalid UTF-8 are rejected; every
+// other character is left to dialect quoting.
+func ValidateIdentifier(name string) error {
+	if name == "" {
+		return errors.New("identifier cannot be empty")
+	}
+	if strings.IndexByte(name, 0) >= 0 {
+		return fmt.Errorf("identifier %q contains a NUL byte", name)
+	}
+	if !utf8.ValidString(name) {
+		return fmt.Errorf("identifier %q is not valid UTF-8", name)
+	}
+	return nil
+}
+
+// ValidateIdentifiers traverses node and returns the first invalid column or
+// table identifier found, or the construction error of a nested statement.
+// Statement builders call it while recording construction errors.
+func ValidateIdentifiers(node Node) error {
+	v := &identifierValidator{}
+	// Traversal errors other than identifier errors belong to compilation, so
+	// only the recorded identifier error is reported.
+	_ = node.Accept(v)
+	return v.err
+}
+
+// identifierValidator walks a semantic tree and stops at the first invalid
+// identifier.
+type identifierValidator struct {
+	err error
+}
+
+var _ Visitor = (*identifierValidator)(nil)
+
+func (v *identifierValidator) fail(err error) error {
+	if v.err == nil {
+		v.err = err
+	}
+	return v.err
+}
+
+func (v *identifierValidator) VisitColumnRef(column ColumnRefNode) error {
+	if err := ValidateIdentifier(column.Name()); err != nil {
+		return v.fail(fmt.Errorf("invalid column name: %w", err))
+	}
+	if column.Table() != "" {
+		if err := ValidateIdentifier(column.Table()); err != nil {
+			return v.fail(fmt.Errorf("invalid column table: %w", err))
+		}
+	}
+	if column.Schema() != "" {
+		if err := ValidateIdentifier(column.Schema()); err != nil {
+			return v.fail(fmt.Errorf("invalid column schema: %w", err))
+		}
+	}
+	return nil
+}
+
+func (v *identifierValidator) VisitTableRef(table TableRefNode) error {
+	if err := ValidateIdentifier(table.Name()); err != nil {
+		return v.fail(fmt.Errorf("invalid table name: %w", err))
+	}
+	if table.Schema() != "" {
+		if err := ValidateIdentifier(table.Schema()); err != nil {
+			return v.fail(fmt.Errorf("invalid table schema: %w", err))
+		}
+	}
+	if table.Alias() != "" {
+		if err := ValidateIdentifier(table.Alias()); err != nil {
+			return v.fail(fmt.Errorf("invalid table alias: %w", err))
+		}
+	}
+	return nil
+}
+
+func (v *identifierValidator) VisitStatement(stmt StatementNode) error {
+	if err := stmt.Err(); err != nil {
+		return v.fail(err)
+	}
+	return nil
+}
+
+func (v *identifierValidator) VisitSetOperation(stmt SetOperationNode) error {
+	if err := v.VisitStatement(stmt); err != nil {
+		return err
+	}
+	if with := stmt.WithClause(); with != nil {
+		if err := with.Accept(v); err != nil {
+			return err
+		}
+	}
+	if err := stmt.Left().Accept(v); err != nil {
+		return err
+	}
+	if err := stmt.Right().Accept(v); err != nil {
+		return err
+	}
+	if ordering := stmt.Ordering(); ordering != nil {
+		return ordering.Accept(v)
+	}
+	return nil
+}
+
+func (v *identifierValidator) VisitFromSource(source FromSourceNode) error {
+	if table := source.Table(); table != nil {
+		if err := table.Accept(v); err != nil {
+			return err
+		}
+	}
+	if join := source.Join(); join != nil {
+		return join.Accept(v)
+	}
+	return nil
+}
+
+func (v *identifierValidator) VisitInsert(stmt InsertStatementNode) error {
+	if err := v.VisitStatement(stmt); err != nil {
+		return err
+	}
+	if table := stmt.Table(); table != nil {
+		if err := table.Accept(v); err != nil {
+			return err
+		}
+	}
+	for _, column := range stmt.Columns() {
+		if err := ValidateIdentifier(column); err != nil {
+			return v.fail(fmt.Errorf("invalid INSERT column: %w", err))
+		}
+	}
+	for _, row := range stmt.Rows() {
+		if err := row.Accept(v); err != nil {
+			return err
+		}
+	}
+	if query := stmt.Query(); query != nil {
+		if err := query.Accept(v); err != nil {
+			return err
+		}
+	}
+	if conflict := stmt.ConflictClause(); conflict != nil {
+		for _, assignment := range conflict.Assignments() {
+			if err := assignment.Value.Accept(v); err != nil {
+				return err
+			}
+		}
+	}
+	if returning := stmt.ReturningClause(); returning != nil {
+		return returning.Accept(v)
+	}
+	return nil
+}
+
+func (v *identifierValidator) VisitUpdate(stmt UpdateStatementNode) error {
+	if err := v.VisitStatement(stmt); err != nil {
+		return err
+	}
+	if table := stmt.Table(); table != nil {
+		if err := table.Accept(v); err != nil {
+			return err
+		}
+	}
+	for _, assignment := range stmt.Assignments() {
+		if err := ValidateIdentifier(assignment.Column); err != nil {
+			return v.fail(fmt.Errorf("invalid SET column: %w", err))
+		}
+		if err := assignment.Value.Accept(v); err != nil {
+			return err
+		}
+	}
+	for _, source := range stmt.Sources() {
+		if err := source.Accept(v); err != nil {
+			return err
+		}
+	}
+	if condition := stmt.Condition(); condition != nil {
+		if err := condition.Accept(v); err != nil {
+			return err
+		}
+	}
+	if returning := stmt.ReturningClause(); returning != nil {
+		return returning.Accept(v)
+	}
+	return nil
+}
+
+func (v *identifierValidator) VisitDelete(stmt DeleteStatementNode) error {
+	if err := v.VisitStatement(stmt); err != nil {
+		return err
+	}
+	if table := stmt.Table(); table != nil {
+		if err := table.Accept(v); err != nil {
+			return err
+		}
+	}
+	for _, source := range stmt.Sources() {
+		if err := source.Accept(v); err != nil {
+			return err
+		}
+	}
+	if condition := stmt.Condition(); condition != nil {
+		if err := condition.Accept(v); err != nil {
+			return err
+		}
+	}
+	if returning := stmt.ReturningClause(); returning != nil {
+		return returning.Accept(v)
+	}
+	return nil
+}
+
+func (v *identifierValidator) VisitJoin(j JoinNode) error {
+	if right := j.Right(); right != nil {
+		if table := right.Table(); table != nil {
+			if err := table.Accept(v); err != nil {
+				return err
+			}
+		}
+	}
+	if on := j.On(); on != nil {
+		if err := on.Accept(v); err != nil {
+			return err
+		}
+	}
+	for _, column := range j.Using() {
+		if err := ValidateIdentifier(column); err != nil {
+			return v.fail(fmt.Errorf("invalid USING column: %w", err))
+		}
+	}
+	if right := j.Right(); right != nil {
+		if next := right.Join(); next != nil {
+			return next.Accept(v)
+		}
+	}
+	return nil
+}
+
+func (v *identifierValidator) VisitCast(e CastExpressionNode) error {
+	return e.Operand().Accept(v)
+}
+
+func (v *identifierValidator) VisitConcat(e ConcatExpressionNode) error {
+	for _, operand := range e.Operands() {
+		if err := operand.Accept(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *identifierValidator) VisitDerivedTable(d DerivedTableNode) error {
+	if err := ValidateIdentifier(d.Alias()); err != nil {
+		return v.fail(fmt.Errorf("invalid derived table alias: %w", err))
+	}
+	for _, column := range d.Columns() {
+		if err := ValidateIdentifier(column); err != nil {
+			return v.fail(fmt.Errorf("invalid derived table column: %w", err))
+		}
+	}
+	if query := d.Query(); query != nil {
+		return query.Accept(v)
+	}
+	return nil
+}
+
+func (v *identifierValidator) VisitDistinct(d DistinctClauseNode) error {
+	if on := d.On(); on != nil {
+		return on.Accept(v)
+	}
+	return nil
+}
+
+func (v *identifierValidator) VisitDistinctFrom(e DistinctFromExpressionNode) error {
+	if err := e.Left().Accept(v); err != nil {
+		return err
+	}
+	return e.Right().Accept(v)
+}
+
+func (v *identifierValidator) VisitPattern(e PatternExpressionNode) error {
+	if err := e.Subject().Accept(v); err != nil {
+		return err
+	}
+	return e.Pattern().Accept(v)
+}
+
+func (v *identifierValidator) VisitOrdering(o OrderingNode) error {
+	return o.Expression().Accept(v)
+}
+
+func (v *identifierValidator) VisitLimit(LimitClauseNode, LimitPosition) error {
+	return nil
+}
+
+func (v *identifierValidator) VisitWith(w WithClauseNode) error {
+	for _, cte := range w.Tables() {
+		if err := ValidateIdentifier(cte.Name()); err != nil {
+			return v.fail(fmt.Errorf("invalid common table expression name: %w", err))
+		}
+		for _, column := range cte.Columns() {
+			if err := ValidateIdentifier(column); err != nil {
+				return v.fail(fmt.Errorf("invalid common table expression column: %w", err))
+			}
+		}
+		if err := cte.Query().Accept(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *identifierValidator) VisitClause(ClauseNode) error {
+	return nil
+}
+
+func (v *identifierValidator) VisitExpression(expr ExpressionNode) error {
+	if e, ok := expr.(AliasedExpressionNode); ok {
+		if err := ValidateIdentifier(e.Alias()); err != nil {
+			return v.fail(fmt.Errorf("invalid column alias: %w", err))
+		}
+	}
+	if e, ok := expr.(*ExcludedColumn); ok {
+		if err := ValidateIdentifier(e.Column()); err != nil {
+			return v.fail(fmt.Errorf("invalid EXCLUDED column: %w", err))
+		}
+	}
+	return nil
+}
+
+func (v *identifierValidator) VisitExpressionGroupStart() error {
+	return nil
+}
+
+func (v *identifierValidator) VisitExpressionGroupEnd() error {
+	return nil
+}
+
+func (v *identifierValidator) VisitListSeparator(int) error {
+	return nil
+}
@@ -0,0 +1,45 @@
+package sst
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIdentifier(t *testing.T) {
+	assert.NoError(t, ValidateIdentifier("users"))
+	assert.NoError(t, ValidateIdentifier(`weird "name"`))
+	assert.EqualError(t, ValidateIdentifier(""), "identifier cannot be empty")
+	assert.EqualError(t, ValidateIdentifier("id\x00"), `identifier "id\x00" contains a NUL byte`)
+	assert.EqualError(t, ValidateIdentifier("\xff"), `identifier "\xff" is not valid UTF-8`)
+}
+
+func TestValidateIdentifiers(t *testing.T) {
+	t.Run("should accept valid references", func(t *testing.T) {
+		expr := And(
+			Eq(NewColumnRef("users", "id"), NewBindParam(1)),
+			Eq(NewColumnRef("", "name", WithColumnSchema("public")), NewLiteral(2)),
+		)
+
+		assert.NoError(t, ValidateIdentifiers(expr))
+	})
+
+	t.Run("should reject an empty column name inside an expression", func(t *testing.T) {
+		expr := Or(
+			Eq(NewColumnRef("users", "id"), NewBindParam(1)),
+			Eq(NewColumnRef("users", ""), NewBindParam(2)),
+		)
+
+		assert.EqualError(t, ValidateIdentifiers(expr), "invalid column name: identifier cannot be empty")
+	})
+
+	t.Run("should reject a NUL byte in a table reference", func(t *testing.T) {
+		table := NewTableRef("users", WithTableSchema("pub\x00lic"))
+
+		assert.EqualError(
+			t,
+			ValidateIdentifiers(table),
+			`invalid table schema: identifier "pub\x00lic" contains a NUL byte`,
+		)
+	})
+}